@@ -6,7 +6,9 @@ package main
 import (
 	"context"
 	"flag"
+	"io"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
@@ -15,9 +17,20 @@ import (
 
 func main() {
 	var debugMode bool
+	var generateImport bool
+	var outputFile string
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.BoolVar(&generateImport, "generate-import", false, "generate import blocks and resource configuration for existing policy objects, using NSXT_* environment variables for connection")
+	flag.StringVar(&outputFile, "out", "", "output file for generated configuration, standard output is used if not specified")
 	flag.Parse()
 
+	if generateImport {
+		if err := generateImportConfig(outputFile); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	opts := &plugin.ServeOpts{
 		ProviderFunc: func() *schema.Provider {
 			return nsxt.Provider()
@@ -34,3 +47,16 @@ func main() {
 
 	plugin.Serve(opts)
 }
+
+func generateImportConfig(outputFile string) error {
+	var w io.Writer = os.Stdout
+	if outputFile != "" {
+		f, err := os.Create(outputFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return nsxt.GenerateImportConfig(w)
+}
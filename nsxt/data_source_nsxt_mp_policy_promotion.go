@@ -0,0 +1,306 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/migration"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/migration/mp_policy_promotion"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
+)
+
+func dataSourceNsxtMPPolicyPromotion() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtMPPolicyPromotionRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"resource": {
+				Type:        schema.TypeList,
+				Description: "MP objects to check or promote",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Description:  "Terraform resource type of MP objects",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(getMPPolicyPromotionResourceTypes(), false),
+						},
+						"ids": {
+							Type:        schema.TypeList,
+							Description: "IDs of MP objects",
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"promote": {
+				Type:        schema.TypeBool,
+				Description: "Trigger promotion of MP objects that are not promoted yet",
+				Optional:    true,
+				Default:     false,
+			},
+			"skip_failed_resources": {
+				Type:        schema.TypeBool,
+				Description: "Proceed with promotion of other objects if promotion of an object fails",
+				Optional:    true,
+				Default:     false,
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Promotion timeout in seconds",
+				Optional:     true,
+				Default:      1200,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"delay": {
+				Type:         schema.TypeInt,
+				Description:  "Initial delay to start promotion checks in seconds",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "State of MP to policy promotion",
+				Computed:    true,
+			},
+			"object": {
+				Type:        schema.TypeList,
+				Description: "Promoted objects",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Description: "Terraform resource type of MP object",
+							Computed:    true,
+						},
+						"mp_id": {
+							Type:        schema.TypeString,
+							Description: "ID of MP object",
+							Computed:    true,
+						},
+						"policy_type": {
+							Type:        schema.TypeString,
+							Description: "Terraform resource type of policy object",
+							Computed:    true,
+						},
+						"policy_id": {
+							Type:        schema.TypeString,
+							Description: "ID of policy object",
+							Computed:    true,
+						},
+						"policy_path": {
+							Type:        schema.TypeString,
+							Description: "Path of policy object",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"pending_ids": {
+				Type:        schema.TypeList,
+				Description: "IDs of MP objects that are not promoted",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// mpPolicyPromotionRequest is a requested MP object
+type mpPolicyPromotionRequest struct {
+	resourceType string
+	id           string
+}
+
+func getMPPolicyPromotionRequests(d *schema.ResourceData) []mpPolicyPromotionRequest {
+	var requests []mpPolicyPromotionRequest
+	for _, item := range d.Get("resource").([]interface{}) {
+		data := item.(map[string]interface{})
+		for _, id := range interface2StringList(data["ids"].([]interface{})) {
+			requests = append(requests, mpPolicyPromotionRequest{resourceType: data["type"].(string), id: id})
+		}
+	}
+	return requests
+}
+
+// findMPPolicyPromotedObjects returns promoted objects for requests, keyed by MP ID
+func findMPPolicyPromotedObjects(connector client.Connector, requests []mpPolicyPromotionRequest) (map[string]model.MigratedObject, error) {
+	promoted := make(map[string]model.MigratedObject)
+	listed := make(map[string]bool)
+	for _, request := range requests {
+		promotionType := mpPolicyPromotionResources[request.resourceType].promotionType
+		if listed[promotionType] {
+			continue
+		}
+		objects, err := listMPPolicyPromotedObjects(connector, promotionType)
+		if err != nil {
+			return nil, err
+		}
+		for id, obj := range objects {
+			promoted[id] = obj
+		}
+		listed[promotionType] = true
+	}
+	return promoted, nil
+}
+
+func getMPPolicyPromotionState(connector client.Connector) (string, error) {
+	client := mp_policy_promotion.NewStateClient(connector)
+	state, err := client.Get()
+	if err != nil {
+		return "", err
+	}
+	if state.MpPolicyPromotion == nil {
+		return model.MPPolicyPromotionState_MP_POLICY_PROMOTION_PROMOTION_NOT_IN_PROGRESS, nil
+	}
+	return *state.MpPolicyPromotion, nil
+}
+
+func promoteMPPolicyObjects(d *schema.ResourceData, connector client.Connector, requests []mpPolicyPromotionRequest) error {
+	idsByType := make(map[string][]model.MPResourceDetails)
+	for _, request := range requests {
+		id := request.id
+		promotionType := mpPolicyPromotionResources[request.resourceType].promotionType
+		idsByType[promotionType] = append(idsByType[promotionType], model.MPResourceDetails{ManagerId: &id})
+	}
+	var promotionTypes []string
+	for promotionType := range idsByType {
+		promotionTypes = append(promotionTypes, promotionType)
+	}
+	sort.Strings(promotionTypes)
+
+	mode := model.MpMigrationData_MODE_GENERIC
+	skipFailed := d.Get("skip_failed_resources").(bool)
+	migrationData := model.MpMigrationData{
+		Mode:                &mode,
+		SkipFailedResources: &skipFailed,
+	}
+	for _, promotionType := range promotionTypes {
+		resourceType := promotionType
+		migrationData.MigrationData = append(migrationData.MigrationData, model.MPResource{
+			Type_:       &resourceType,
+			ResourceIds: idsByType[promotionType],
+		})
+	}
+
+	log.Printf("[INFO] Triggering MP to policy promotion of %d objects", len(requests))
+	client := migration.NewMpToPolicyClient(connector)
+	if err := client.Create(migrationData); err != nil {
+		return logAPIError("Error triggering MP to policy promotion", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			model.MPPolicyPromotionState_MP_POLICY_PROMOTION_PROMOTION_IN_PROGRESS,
+			model.MPPolicyPromotionState_MP_POLICY_PROMOTION_CANCELLING_PROMOTION,
+		},
+		Target: []string{model.MPPolicyPromotionState_MP_POLICY_PROMOTION_PROMOTION_NOT_IN_PROGRESS},
+		Refresh: func() (interface{}, string, error) {
+			state, err := getMPPolicyPromotionState(connector)
+			if err != nil {
+				return state, "", logAPIError("Error getting MP to policy promotion state", err)
+			}
+			log.Printf("[DEBUG] Current MP to policy promotion state is %s", state)
+			return state, state, nil
+		},
+		Timeout:    time.Duration(d.Get("timeout").(int)) * time.Second,
+		MinTimeout: 1 * time.Second,
+		Delay:      time.Duration(d.Get("delay").(int)) * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func dataSourceNsxtMPPolicyPromotionRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+
+	requests := getMPPolicyPromotionRequests(d)
+	promoted, err := findMPPolicyPromotedObjects(connector, requests)
+	if err != nil {
+		return handleDataSourceReadError(d, "MP Policy Promotion", "", err)
+	}
+
+	var pending []mpPolicyPromotionRequest
+	for _, request := range requests {
+		if _, ok := promoted[request.id]; !ok {
+			pending = append(pending, request)
+		}
+	}
+
+	state, err := getMPPolicyPromotionState(connector)
+	if err != nil {
+		return handleDataSourceReadError(d, "MP Policy Promotion", "", err)
+	}
+
+	if len(pending) > 0 && d.Get("promote").(bool) {
+		if state != model.MPPolicyPromotionState_MP_POLICY_PROMOTION_PROMOTION_NOT_IN_PROGRESS {
+			return fmt.Errorf("Can not start MP to policy promotion, promotion state is %s", state)
+		}
+		if err := promoteMPPolicyObjects(d, connector, pending); err != nil {
+			return err
+		}
+
+		promoted, err = findMPPolicyPromotedObjects(connector, requests)
+		if err != nil {
+			return handleDataSourceReadError(d, "MP Policy Promotion", "", err)
+		}
+		state, err = getMPPolicyPromotionState(connector)
+		if err != nil {
+			return handleDataSourceReadError(d, "MP Policy Promotion", "", err)
+		}
+	}
+
+	var objList []map[string]interface{}
+	var pendingIDs []string
+	for _, request := range requests {
+		obj, ok := promoted[request.id]
+		if !ok {
+			pendingIDs = append(pendingIDs, request.id)
+			continue
+		}
+		elem := make(map[string]interface{})
+		elem["type"] = request.resourceType
+		elem["mp_id"] = request.id
+		elem["policy_type"] = mpPolicyPromotionResources[request.resourceType].policyResource
+		elem["policy_path"] = obj.PolicyPath
+		if obj.PolicyId != nil {
+			elem["policy_id"] = obj.PolicyId
+		} else {
+			elem["policy_id"] = getPolicyIDFromPath(*obj.PolicyPath)
+		}
+		objList = append(objList, elem)
+	}
+
+	if len(pendingIDs) > 0 && d.Get("promote").(bool) && !d.Get("skip_failed_resources").(bool) {
+		return fmt.Errorf("Failed to promote MP objects to policy: %s", strings.Join(pendingIDs, ", "))
+	}
+
+	d.Set("state", state)
+	d.Set("object", objList)
+	d.Set("pending_ids", pendingIDs)
+	d.SetId(newUUID())
+	return nil
+}
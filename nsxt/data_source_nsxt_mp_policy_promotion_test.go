@@ -0,0 +1,123 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNsxtMPPolicyPromotion_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_mp_policy_promotion.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.2.0")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtMPPolicyPromotionTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "object.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "object.0.policy_type", "nsxt_policy_group"),
+					resource.TestCheckResourceAttrSet(testResourceName, "object.0.policy_path"),
+					resource.TestCheckResourceAttr(testResourceName, "pending_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtMPPolicyPromotionTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_ip_set" "test" {
+  display_name = "%s"
+  ip_addresses = ["10.0.0.1"]
+}
+
+data "nsxt_mp_policy_promotion" "test" {
+  resource {
+    type = "nsxt_ip_set"
+    ids  = [nsxt_ip_set.test.id]
+  }
+  promote = true
+}`, name)
+}
+
+func TestMPPolicyPromotion(t *testing.T) {
+	server, provider := testSimulatorProvider(t, "", nil)
+
+	if err := server.Put("/api/v1/logical-switches/ls1", map[string]interface{}{"resource_type": "LogicalSwitch", "display_name": "web"}); err != nil {
+		t.Fatal(err)
+	}
+	m := provider.Meta()
+
+	ds := provider.DataSourcesMap["nsxt_mp_policy_promotion"]
+	config := map[string]interface{}{
+		"resource": []interface{}{
+			map[string]interface{}{"type": "nsxt_logical_switch", "ids": []interface{}{"ls1", "ls2"}},
+		},
+		"skip_failed_resources": true,
+		"delay":                 0,
+	}
+	d := schema.TestResourceDataRaw(t, ds.Schema, config)
+	if err := ds.Read(d, m); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("object.#").(int) != 0 || d.Get("pending_ids.#").(int) != 2 {
+		t.Errorf("Expected objects to be pending before promotion, got %v, %v", d.Get("object"), d.Get("pending_ids"))
+	}
+
+	config["promote"] = true
+	d = schema.TestResourceDataRaw(t, ds.Schema, config)
+	if err := ds.Read(d, m); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("object.#").(int) != 1 || d.Get("object.0.policy_path").(string) != "/infra/segments/ls1" || d.Get("object.0.policy_type").(string) != "nsxt_policy_segment" {
+		t.Errorf("Unexpected promoted objects: %v", d.Get("object"))
+	}
+	if pending := d.Get("pending_ids").([]interface{}); len(pending) != 1 || pending[0] != "ls2" {
+		t.Errorf("Expected ls2 to remain pending, got %v", pending)
+	}
+	if d.Get("state").(string) != "PROMOTION_NOT_IN_PROGRESS" {
+		t.Errorf("Unexpected promotion state %s", d.Get("state"))
+	}
+
+	config["skip_failed_resources"] = false
+	d = schema.TestResourceDataRaw(t, ds.Schema, config)
+	if err := ds.Read(d, m); err == nil || !strings.Contains(err.Error(), "ls2") {
+		t.Errorf("Expected promotion failure for ls2, got %v", err)
+	}
+
+	res := provider.ResourcesMap["nsxt_policy_segment"]
+	d = res.Data(nil)
+	d.SetId("nsxt_logical_switch:ls1")
+	if _, err := res.Importer.State(d, m); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "ls1" {
+		t.Errorf("Expected promoted segment ls1 to be imported, got %s", d.Id())
+	}
+
+	d = res.Data(nil)
+	d.SetId("nsxt_logical_switch:ls2")
+	if _, err := res.Importer.State(d, m); err == nil {
+		t.Errorf("Expected import of object that was not promoted to fail")
+	}
+
+	group := provider.ResourcesMap["nsxt_policy_group"]
+	d = group.Data(nil)
+	d.SetId("nsxt_logical_switch:ls1")
+	if _, err := group.Importer.State(d, m); err == nil || !strings.Contains(err.Error(), "nsxt_policy_segment") {
+		t.Errorf("Expected import from mismatching MP resource to fail, got %v", err)
+	}
+}
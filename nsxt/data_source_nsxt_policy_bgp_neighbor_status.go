@@ -0,0 +1,180 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services/bgp/neighbors"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyBgpNeighborStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyBgpNeighborStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"gateway_path": {
+				Type:         schema.TypeString,
+				Description:  "Tier-0 gateway path",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"edge_node_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of edge node to retrieve the status from",
+				Optional:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"neighbor_address": {
+				Type:         schema.TypeString,
+				Description:  "Only return status for this neighbor address",
+				Optional:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"all_established": {
+				Type:        schema.TypeBool,
+				Description: "Whether all returned neighbor sessions are in established state",
+				Computed:    true,
+			},
+			"neighbor": {
+				Type:        schema.TypeList,
+				Description: "BGP neighbor status per edge node",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"neighbor_address": {
+							Type:        schema.TypeString,
+							Description: "Neighbor IP address",
+							Computed:    true,
+						},
+						"source_address": {
+							Type:        schema.TypeString,
+							Description: "Source IP address of the session",
+							Computed:    true,
+						},
+						"edge_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the edge node",
+							Computed:    true,
+						},
+						"remote_as_number": {
+							Type:        schema.TypeString,
+							Description: "AS number of the neighbor",
+							Computed:    true,
+						},
+						"connection_state": {
+							Type:        schema.TypeString,
+							Description: "Current state of the BGP session",
+							Computed:    true,
+						},
+						"established": {
+							Type:        schema.TypeBool,
+							Description: "Whether the BGP session is established",
+							Computed:    true,
+						},
+						"time_since_established": {
+							Type:        schema.TypeInt,
+							Description: "Time in milliseconds since the session was established",
+							Computed:    true,
+						},
+						"received_prefix_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of prefixes received from the neighbor",
+							Computed:    true,
+						},
+						"advertised_prefix_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of prefixes advertised to the neighbor",
+							Computed:    true,
+						},
+						"messages_received": {
+							Type:        schema.TypeInt,
+							Description: "Count of messages received from the neighbor",
+							Computed:    true,
+						},
+						"messages_sent": {
+							Type:        schema.TypeInt,
+							Description: "Count of messages sent to the neighbor",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyBgpNeighborStatusRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if !isT0 || gwID == "" {
+		return fmt.Errorf("BGP neighbor status is only supported for Tier-0 gateways, got %s", gwPath)
+	}
+
+	var edgePath *string
+	if value := d.Get("edge_node_path").(string); value != "" {
+		edgePath = &value
+	}
+	neighborAddress := d.Get("neighbor_address").(string)
+
+	connector := getPolicyConnector(m)
+	lsClient := tier_0s.NewLocaleServicesClient(connector)
+	lsList, err := lsClient.List(gwID, nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "Locale Services for T0", gwID, err)
+	}
+
+	client := neighbors.NewStatusClient(connector)
+	var statuses []model.PolicyBgpNeighborStatus
+	for _, ls := range lsList.Results {
+		result, err := client.List(gwID, *ls.Id, nil, edgePath, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		if err != nil {
+			return handleDataSourceReadError(d, "BGP Neighbor Status", gwID, err)
+		}
+		statuses = append(statuses, result.Results...)
+	}
+
+	allEstablished := true
+	var neighborList []map[string]interface{}
+	for _, status := range statuses {
+		if neighborAddress != "" && (status.NeighborAddress == nil || *status.NeighborAddress != neighborAddress) {
+			continue
+		}
+		established := status.ConnectionState != nil && *status.ConnectionState == model.PolicyBgpNeighborStatus_CONNECTION_STATE_ESTABLISHED
+		if !established {
+			allEstablished = false
+		}
+
+		elem := make(map[string]interface{})
+		elem["neighbor_address"] = status.NeighborAddress
+		elem["source_address"] = status.SourceAddress
+		elem["edge_path"] = status.EdgePath
+		elem["remote_as_number"] = status.RemoteAsNumber
+		elem["connection_state"] = status.ConnectionState
+		elem["established"] = established
+		elem["time_since_established"] = status.TimeSinceEstablished
+		elem["received_prefix_count"] = status.TotalInPrefixCount
+		elem["advertised_prefix_count"] = status.TotalOutPrefixCount
+		elem["messages_received"] = status.MessagesReceived
+		elem["messages_sent"] = status.MessagesSent
+		neighborList = append(neighborList, elem)
+	}
+	if len(neighborList) == 0 {
+		allEstablished = false
+	}
+
+	d.Set("neighbor", neighborList)
+	d.Set("all_established", allEstablished)
+	d.SetId(newUUID())
+
+	return nil
+}
@@ -0,0 +1,43 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyBgpNeighborStatus_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_bgp_neighbor_status.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyBgpNeighborStatusTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "all_established"),
+					resource.TestCheckResourceAttrSet(testResourceName, "neighbor.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyBgpNeighborStatusTemplate() string {
+	return fmt.Sprintf(`
+data "nsxt_policy_tier0_gateway" "test" {
+  display_name = "%s"
+}
+
+data "nsxt_policy_bgp_neighbor_status" "test" {
+  gateway_path = data.nsxt_policy_tier0_gateway.test.path
+}`, getTier0RouterName())
+}
@@ -0,0 +1,183 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/dhcp_server_configs"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	project_dhcp_server_configs "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/dhcp_server_configs"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+var policyDhcpLeasesSourceValues = []string{
+	dhcp_server_configs.Leases_LIST_SOURCE_REALTIME,
+	dhcp_server_configs.Leases_LIST_SOURCE_CACHED,
+}
+
+func dataSourceNsxtPolicyDhcpLeases() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyDhcpLeasesRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":                getDataSourceIDSchema(),
+			"context":           getContextSchema(false, false, false),
+			"dhcp_server_path":  getPolicyDhcpServerPathSchema(),
+			"connectivity_path": getPolicyDhcpConnectivityPathSchema(),
+			"segment_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of segment to filter leases by, when connectivity path is a gateway",
+				Optional:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Description: "IP or MAC address to filter leases by",
+				Optional:    true,
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Description:  "Data source type",
+				Optional:     true,
+				Default:      dhcp_server_configs.Leases_LIST_SOURCE_REALTIME,
+				ValidateFunc: validation.StringInSlice(policyDhcpLeasesSourceValues, false),
+			},
+			"lease": {
+				Type:        schema.TypeList,
+				Description: "Active DHCP leases",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:        schema.TypeString,
+							Description: "Leased IP address",
+							Computed:    true,
+						},
+						"mac_address": {
+							Type:        schema.TypeString,
+							Description: "MAC address of the client",
+							Computed:    true,
+						},
+						"subnet": {
+							Type:        schema.TypeString,
+							Description: "Subnet of the lease",
+							Computed:    true,
+						},
+						"lease_time": {
+							Type:        schema.TypeString,
+							Description: "Lease time",
+							Computed:    true,
+						},
+						"start_time": {
+							Type:        schema.TypeString,
+							Description: "Lease start time",
+							Computed:    true,
+						},
+						"expire_time": {
+							Type:        schema.TypeString,
+							Description: "Lease expire time",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getPolicyDhcpServerPathSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Policy path of DHCP server",
+		Required:     true,
+		ValidateFunc: validatePolicyPath(),
+	}
+}
+
+func getPolicyDhcpConnectivityPathSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Policy path of segment or gateway the DHCP server is attached to",
+		Required:     true,
+		ValidateFunc: validatePolicyPath(),
+	}
+}
+
+func listPolicyDhcpLeases(context utl.SessionContext, connector client.Connector, serverID string, connectivityPath string, address *string, segmentPath *string, source *string) ([]model.DhcpLeasePerIP, error) {
+	var leases []model.DhcpLeasePerIP
+	var cursor *string
+	for {
+		var result model.DhcpLeasesResult
+		var err error
+		switch context.ClientType {
+		case utl.Local:
+			result, err = dhcp_server_configs.NewLeasesClient(connector).List(serverID, connectivityPath, address, cursor, nil, nil, nil, nil, segmentPath, nil, nil, source)
+		case utl.Multitenancy:
+			result, err = project_dhcp_server_configs.NewLeasesClient(connector).List(defaultOrgID, context.ProjectID, serverID, connectivityPath, address, cursor, nil, nil, nil, nil, segmentPath, nil, nil, source)
+		default:
+			return nil, policyResourceNotSupportedError()
+		}
+		if err != nil {
+			return nil, err
+		}
+		leases = append(leases, result.Leases...)
+		if result.Cursor == nil || *result.Cursor == "" || len(result.Leases) == 0 {
+			return leases, nil
+		}
+		cursor = result.Cursor
+	}
+}
+
+func dataSourceNsxtPolicyDhcpLeasesRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	serverPath := d.Get("dhcp_server_path").(string)
+	serverID := getPolicyIDFromPath(serverPath)
+	if serverID == "" {
+		return fmt.Errorf("dhcp_server_path %s is not valid", serverPath)
+	}
+	connectivityPath := d.Get("connectivity_path").(string)
+
+	var address, segmentPath *string
+	if value := d.Get("address").(string); value != "" {
+		address = &value
+	}
+	if value := d.Get("segment_path").(string); value != "" {
+		segmentPath = &value
+	}
+	source := d.Get("source").(string)
+
+	leases, err := listPolicyDhcpLeases(getSessionContext(d, m), connector, serverID, connectivityPath, address, segmentPath, &source)
+	if err != nil {
+		return handleDataSourceReadError(d, "DHCP Leases", serverID, err)
+	}
+
+	var leaseList []map[string]interface{}
+	for _, lease := range leases {
+		elem := make(map[string]interface{})
+		elem["ip_address"] = lease.IpAddress
+		elem["mac_address"] = lease.MacAddress
+		elem["subnet"] = lease.Subnet
+		elem["lease_time"] = lease.LeaseTime
+		elem["start_time"] = lease.StartTime
+		elem["expire_time"] = lease.ExpireTime
+		leaseList = append(leaseList, elem)
+	}
+
+	err = d.Set("lease", leaseList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
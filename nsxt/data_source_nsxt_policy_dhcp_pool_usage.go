@@ -0,0 +1,105 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/dhcp_server_configs"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	project_dhcp_server_configs "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/dhcp_server_configs"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func dataSourceNsxtPolicyDhcpPoolUsage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyDhcpPoolUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":                getDataSourceIDSchema(),
+			"context":           getContextSchema(false, false, false),
+			"dhcp_server_path":  getPolicyDhcpServerPathSchema(),
+			"connectivity_path": getPolicyDhcpConnectivityPathSchema(),
+			"pool": {
+				Type:        schema.TypeList,
+				Description: "Usage of DHCP pools",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pool_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the DHCP pool",
+							Computed:    true,
+						},
+						"pool_size": {
+							Type:        schema.TypeInt,
+							Description: "Number of addresses in the pool",
+							Computed:    true,
+						},
+						"allocated_number": {
+							Type:        schema.TypeInt,
+							Description: "Number of allocated addresses",
+							Computed:    true,
+						},
+						"allocated_percentage": {
+							Type:        schema.TypeInt,
+							Description: "Percentage of allocated addresses",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getPolicyDhcpServerStatistics(context utl.SessionContext, connector client.Connector, serverID string, connectivityPath string) (model.DhcpServerStatistics, error) {
+	switch context.ClientType {
+	case utl.Local:
+		return dhcp_server_configs.NewStatsClient(connector).Get(serverID, connectivityPath, nil, nil, nil, nil, nil, nil, nil)
+	case utl.Multitenancy:
+		return project_dhcp_server_configs.NewStatsClient(connector).Get(defaultOrgID, context.ProjectID, serverID, connectivityPath, nil, nil, nil, nil, nil, nil, nil)
+	}
+	return model.DhcpServerStatistics{}, policyResourceNotSupportedError()
+}
+
+func dataSourceNsxtPolicyDhcpPoolUsageRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	serverPath := d.Get("dhcp_server_path").(string)
+	serverID := getPolicyIDFromPath(serverPath)
+	if serverID == "" {
+		return fmt.Errorf("dhcp_server_path %s is not valid", serverPath)
+	}
+	connectivityPath := d.Get("connectivity_path").(string)
+
+	stats, err := getPolicyDhcpServerStatistics(getSessionContext(d, m), connector, serverID, connectivityPath)
+	if err != nil {
+		return handleDataSourceReadError(d, "DHCP Server Statistics", serverID, err)
+	}
+
+	var poolList []map[string]interface{}
+	for _, pool := range stats.IpPoolStats {
+		elem := make(map[string]interface{})
+		elem["pool_id"] = pool.DhcpIpPoolId
+		elem["pool_size"] = pool.PoolSize
+		elem["allocated_number"] = pool.AllocatedNumber
+		elem["allocated_percentage"] = pool.AllocatedPercentage
+		poolList = append(poolList, elem)
+	}
+
+	err = d.Set("pool", poolList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
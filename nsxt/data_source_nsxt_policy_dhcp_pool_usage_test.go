@@ -0,0 +1,39 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyDhcpPoolUsage_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_dhcp_pool_usage.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDhcpPoolUsageTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "pool.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyDhcpPoolUsageTemplate() string {
+	return testAccNsxtPolicyDhcpStaticBindingPrerequisites(false, false, false) + `
+data "nsxt_policy_dhcp_pool_usage" "test" {
+  dhcp_server_path  = nsxt_policy_dhcp_server.test.path
+  connectivity_path = nsxt_policy_segment.test.path
+}`
+}
@@ -0,0 +1,201 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func dataSourceNsxtPolicyDriftReport() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyDriftReportRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"parent_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path under which objects are reported",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"resource_types": {
+				Type:        schema.TypeSet,
+				Description: "NSX resource types of objects to report, for example Group or Rule",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"expected_user": {
+				Type:        schema.TypeString,
+				Description: "User or principal identity expected to create and modify objects, provider username by default",
+				Optional:    true,
+			},
+			"object": {
+				Type:        schema.TypeList,
+				Description: "Policy objects found under parent path",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "Object ID",
+							Computed:    true,
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Object policy path",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Object display name",
+							Computed:    true,
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Description: "Object NSX resource type",
+							Computed:    true,
+						},
+						"create_user": {
+							Type:        schema.TypeString,
+							Description: "User that created the object",
+							Computed:    true,
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Description: "Creation time, in RFC3339 format",
+							Computed:    true,
+						},
+						"last_modified_user": {
+							Type:        schema.TypeString,
+							Description: "User that last modified the object",
+							Computed:    true,
+						},
+						"last_modified_time": {
+							Type:        schema.TypeString,
+							Description: "Last modification time, in RFC3339 format",
+							Computed:    true,
+						},
+						"created_externally": {
+							Type:        schema.TypeBool,
+							Description: "Whether the object was created by a user other than expected user",
+							Computed:    true,
+						},
+						"modified_externally": {
+							Type:        schema.TypeBool,
+							Description: "Whether the object was last modified by a user other than expected user",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"created_externally_paths": {
+				Type:        schema.TypeList,
+				Description: "Paths of objects created by a user other than expected user",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"modified_externally_paths": {
+				Type:        schema.TypeList,
+				Description: "Paths of objects last modified by a user other than expected user",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func formatPolicyTimestamp(timestamp *int64) string {
+	if timestamp == nil {
+		return ""
+	}
+	return time.UnixMilli(*timestamp).UTC().Format(time.RFC3339)
+}
+
+func dataSourceNsxtPolicyDriftReportRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	parentPath := d.Get("parent_path").(string)
+	expectedUser := d.Get("expected_user").(string)
+	if expectedUser == "" {
+		expectedUser = getCommonProviderConfig(m).Username
+	}
+	if expectedUser == "" {
+		return fmt.Errorf("expected_user needs to be specified when provider is not configured with username")
+	}
+
+	context := utl.SessionContext{ClientType: utl.Local}
+	if isPolicyGlobalManager(m) {
+		context.ClientType = utl.Global
+	}
+
+	var objects []model.PolicyResource
+	converter := bindings.NewTypeConverter()
+	for _, resourceType := range interface2StringList(d.Get("resource_types").(*schema.Set).List()) {
+		results, err := listPolicyResourcesByTypeAndPathPrefix(connector, context, resourceType, parentPath, nil)
+		if err != nil {
+			return handleDataSourceReadError(d, "Drift Report", parentPath, err)
+		}
+		for _, result := range results {
+			dataValue, errs := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+			if len(errs) > 0 {
+				return errs[0]
+			}
+			obj := dataValue.(model.PolicyResource)
+			if obj.ResourceType == nil || *obj.ResourceType != resourceType {
+				continue
+			}
+			objects = append(objects, obj)
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool { return *objects[i].Path < *objects[j].Path })
+
+	var objectList []map[string]interface{}
+	createdExternally := make([]string, 0)
+	modifiedExternally := make([]string, 0)
+	for _, obj := range objects {
+		elem := make(map[string]interface{})
+		elem["id"] = obj.Id
+		elem["path"] = obj.Path
+		elem["display_name"] = obj.DisplayName
+		elem["resource_type"] = obj.ResourceType
+		elem["create_user"] = obj.CreateUser
+		elem["create_time"] = formatPolicyTimestamp(obj.CreateTime)
+		elem["last_modified_user"] = obj.LastModifiedUser
+		elem["last_modified_time"] = formatPolicyTimestamp(obj.LastModifiedTime)
+		created := obj.CreateUser != nil && *obj.CreateUser != expectedUser
+		modified := obj.LastModifiedUser != nil && *obj.LastModifiedUser != expectedUser
+		elem["created_externally"] = created
+		elem["modified_externally"] = modified
+		if created {
+			createdExternally = append(createdExternally, *obj.Path)
+		}
+		if modified {
+			modifiedExternally = append(modifiedExternally, *obj.Path)
+		}
+		objectList = append(objectList, elem)
+	}
+
+	if err := d.Set("object", objectList); err != nil {
+		return err
+	}
+	d.Set("created_externally_paths", createdExternally)
+	d.Set("modified_externally_paths", modifiedExternally)
+
+	d.SetId(newUUID())
+	return nil
+}
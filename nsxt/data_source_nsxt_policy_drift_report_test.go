@@ -0,0 +1,102 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNsxtPolicyDriftReport_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "data.nsxt_policy_drift_report.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDriftReportTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttr(testResourceName, "object.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "object.0.display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "object.0.resource_type", "Rule"),
+					resource.TestCheckResourceAttr(testResourceName, "object.0.created_externally", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "object.0.last_modified_time"),
+					resource.TestCheckResourceAttr(testResourceName, "created_externally_paths.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyDriftReportTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_security_policy" "test" {
+  display_name = "%s"
+  category     = "Application"
+
+  rule {
+    display_name = "%s"
+    action       = "ALLOW"
+  }
+}
+
+data "nsxt_policy_drift_report" "test" {
+  parent_path    = nsxt_policy_security_policy.test.path
+  resource_types = ["Rule"]
+}`, name, name)
+}
+
+func TestPolicyDriftReportRead(t *testing.T) {
+	server, provider := testSimulatorProvider(t, "", nil)
+
+	policyPath := "/infra/domains/default/security-policies/app"
+	objects := []struct {
+		path string
+		obj  map[string]interface{}
+		user string
+	}{
+		{policyPath, map[string]interface{}{"display_name": "app"}, "admin"},
+		{policyPath + "/rules/r1", map[string]interface{}{"display_name": "r1", "resource_type": "Rule"}, "admin"},
+		{policyPath + "/rules/r2", map[string]interface{}{"display_name": "r2", "resource_type": "Rule"}, "ui-user"},
+		{policyPath + "/rules/r1", map[string]interface{}{"display_name": "r1-edited", "resource_type": "Rule"}, "ui-user"},
+		{"/infra/domains/default/groups/g1", map[string]interface{}{"display_name": "g1"}, "ui-user"},
+	}
+	for _, o := range objects {
+		if err := server.PutAs(o.path, o.obj, o.user); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ds := dataSourceNsxtPolicyDriftReport()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"parent_path":    policyPath,
+		"resource_types": []interface{}{"Rule", "Group"},
+	})
+	if err := ds.Read(d, provider.Meta()); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Get("object.#").(int) != 2 {
+		t.Fatalf("Expected 2 objects under %s, got %v", policyPath, d.Get("object"))
+	}
+	if d.Get("object.0.created_externally").(bool) || !d.Get("object.0.modified_externally").(bool) || d.Get("object.0.last_modified_user").(string) != "ui-user" {
+		t.Errorf("Unexpected report for rule r1: %v", d.Get("object.0"))
+	}
+	created := d.Get("created_externally_paths").([]interface{})
+	if len(created) != 1 || created[0].(string) != policyPath+"/rules/r2" {
+		t.Errorf("Unexpected externally created paths %v", created)
+	}
+	if len(d.Get("modified_externally_paths").([]interface{})) != 2 {
+		t.Errorf("Unexpected externally modified paths %v", d.Get("modified_externally_paths"))
+	}
+}
@@ -0,0 +1,143 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/firewall_identity_stores"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyFirewallIdentityStoreGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyFirewallIdentityStoreGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"identity_store_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of firewall identity store",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Substring of group distinguished name to search for",
+				Required:    true,
+			},
+			"base_distinguished_name": {
+				Type:        schema.TypeString,
+				Description: "Base distinguished name of the identity store domain",
+				Computed:    true,
+			},
+			"group": {
+				Type:        schema.TypeList,
+				Description: "Synchronized directory groups matching the search",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the group",
+							Computed:    true,
+						},
+						"distinguished_name": {
+							Type:        schema.TypeString,
+							Description: "Distinguished name of the group",
+							Computed:    true,
+						},
+						"domain_name": {
+							Type:        schema.TypeString,
+							Description: "Name of the domain the group belongs to",
+							Computed:    true,
+						},
+						"sid": {
+							Type:        schema.TypeString,
+							Description: "Security identifier of the group",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func listPolicyFirewallIdentityStoreGroups(connector client.Connector, storeID string, filter string) ([]model.DirectoryAdGroup, error) {
+	client := firewall_identity_stores.NewGroupsClient(connector)
+	converter := bindings.NewTypeConverter()
+
+	var groups []model.DirectoryAdGroup
+	var cursor *string
+	for {
+		result, err := client.List(storeID, filter, cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, groupData := range result.Results {
+			obj, errs := converter.ConvertToGolang(groupData, model.DirectoryAdGroupBindingType())
+			if len(errs) > 0 {
+				return nil, errs[0]
+			}
+			groups = append(groups, obj.(model.DirectoryAdGroup))
+		}
+		if result.Cursor == nil || *result.Cursor == "" || len(result.Results) == 0 {
+			return groups, nil
+		}
+		cursor = result.Cursor
+	}
+}
+
+func dataSourceNsxtPolicyFirewallIdentityStoreGroupsRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	storePath := d.Get("identity_store_path").(string)
+	storeID := getPolicyIDFromPath(storePath)
+	if storeID == "" {
+		return fmt.Errorf("identity_store_path %s is not valid", storePath)
+	}
+
+	storeObj, err := infra.NewFirewallIdentityStoresClient(connector).Get(storeID, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "Firewall Identity Store", storeID, err)
+	}
+	converter := bindings.NewTypeConverter()
+	obj, errs := converter.ConvertToGolang(storeObj, model.DirectoryAdDomainBindingType())
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	store := obj.(model.DirectoryAdDomain)
+
+	groups, err := listPolicyFirewallIdentityStoreGroups(connector, storeID, d.Get("name").(string))
+	if err != nil {
+		return handleDataSourceReadError(d, "Firewall Identity Store Groups", storeID, err)
+	}
+
+	var groupList []map[string]interface{}
+	for _, group := range groups {
+		elem := make(map[string]interface{})
+		elem["display_name"] = group.DisplayName
+		elem["distinguished_name"] = group.DistinguishedName
+		elem["domain_name"] = group.DomainName
+		elem["sid"] = group.SecureId
+		groupList = append(groupList, elem)
+	}
+
+	d.Set("base_distinguished_name", store.BaseDistinguishedName)
+	err = d.Set("group", groupList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(storeID)
+	return nil
+}
@@ -0,0 +1,41 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyFirewallIdentityStoreGroups_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_firewall_identity_store_groups.test"
+	name := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckFirewallIdentityStore(t)
+			testAccEnvDefined(t, "NSXT_TEST_LDAP_GROUP_NAME")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallIdentityStoreGroupsTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "base_distinguished_name", getTestLdapBaseDN()),
+					resource.TestCheckResourceAttrSet(testResourceName, "group.0.distinguished_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallIdentityStoreGroupsTemplate(name string) string {
+	return testAccNsxtPolicyFirewallIdentityStoreTemplate(name, 180) + fmt.Sprintf(`
+data "nsxt_policy_firewall_identity_store_groups" "test" {
+  identity_store_path = nsxt_policy_firewall_identity_store.test.path
+  name                = "%s"
+}`, getTestLdapGroupName())
+}
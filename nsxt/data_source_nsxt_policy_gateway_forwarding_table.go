@@ -0,0 +1,56 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyGatewayForwardingTable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyGatewayForwardingTableRead,
+
+		Schema: getPolicyGatewayRouteTableSchema("Tier-0 or Tier-1 gateway path"),
+	}
+}
+
+func dataSourceNsxtPolicyGatewayForwardingTableRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if gwID == "" {
+		return fmt.Errorf("Invalid gateway path %s", gwPath)
+	}
+
+	connector := getPolicyConnector(m)
+	edgePath, networkPrefix := getPolicyGatewayRouteTableFilters(d)
+	var result model.RoutingTableListResult
+	var err error
+	if isT0 {
+		client := tier_0s.NewForwardingTableClient(connector)
+		result, err = client.List(gwID, nil, nil, nil, edgePath, nil, nil, networkPrefix, nil, nil, nil, nil)
+	} else {
+		client := tier_1s.NewForwardingTableClient(connector)
+		result, err = client.List(gwID, nil, nil, nil, edgePath, nil, nil, networkPrefix, nil, nil, nil, nil)
+	}
+	if err != nil {
+		return handleDataSourceReadError(d, "Forwarding Table", gwID, err)
+	}
+
+	err = setPolicyGatewayRouteTableInSchema(d, result.Results)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
@@ -0,0 +1,32 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyGatewayForwardingTable_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_gateway_forwarding_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayRouteTableTemplate("nsxt_policy_gateway_forwarding_table"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "edge.#"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge.0.edge_node"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge.0.status"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,165 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyGatewayRoutingTable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyGatewayRoutingTableRead,
+
+		Schema: getPolicyGatewayRouteTableSchema("Tier-0 gateway path"),
+	}
+}
+
+func getPolicyGatewayRouteTableSchema(gatewayPathDescription string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": getDataSourceIDSchema(),
+		"gateway_path": {
+			Type:         schema.TypeString,
+			Description:  gatewayPathDescription,
+			Required:     true,
+			ValidateFunc: validatePolicyPath(),
+		},
+		"edge_node_path": {
+			Type:         schema.TypeString,
+			Description:  "Policy path of edge node to retrieve the table from",
+			Optional:     true,
+			ValidateFunc: validatePolicyPath(),
+		},
+		"network_prefix": {
+			Type:         schema.TypeString,
+			Description:  "Only return routes for this network prefix",
+			Optional:     true,
+			ValidateFunc: validateCidr(),
+		},
+		"edge": {
+			Type:        schema.TypeList,
+			Description: "Per edge node table",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"edge_node": {
+						Type:        schema.TypeString,
+						Description: "Transport node ID of the edge node",
+						Computed:    true,
+					},
+					"status": {
+						Type:        schema.TypeString,
+						Description: "Table fetch status from the edge node",
+						Computed:    true,
+					},
+					"error_message": {
+						Type:        schema.TypeString,
+						Description: "Table fetch error message",
+						Computed:    true,
+					},
+					"route_entry": {
+						Type:        schema.TypeList,
+						Description: "Route entries",
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"network": {
+									Type:        schema.TypeString,
+									Description: "Network CIDR",
+									Computed:    true,
+								},
+								"next_hop": {
+									Type:        schema.TypeString,
+									Description: "Next hop address",
+									Computed:    true,
+								},
+								"route_type": {
+									Type:        schema.TypeString,
+									Description: "Route type",
+									Computed:    true,
+								},
+								"admin_distance": {
+									Type:        schema.TypeInt,
+									Description: "Admin distance",
+									Computed:    true,
+								},
+								"component_type": {
+									Type:        schema.TypeString,
+									Description: "Logical router component type",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func setPolicyGatewayRouteTableInSchema(d *schema.ResourceData, tables []model.RoutingTable) error {
+	var edges []map[string]interface{}
+	for _, table := range tables {
+		elem := make(map[string]interface{})
+		elem["edge_node"] = table.EdgeNode
+		elem["status"] = table.Status
+		elem["error_message"] = table.ErrorMessage
+		var entries []map[string]interface{}
+		for _, route := range table.RouteEntries {
+			entry := make(map[string]interface{})
+			entry["network"] = route.Network
+			entry["next_hop"] = route.NextHop
+			entry["route_type"] = route.RouteType
+			entry["admin_distance"] = route.AdminDistance
+			entry["component_type"] = route.LrComponentType
+			entries = append(entries, entry)
+		}
+		elem["route_entry"] = entries
+		edges = append(edges, elem)
+	}
+
+	return d.Set("edge", edges)
+}
+
+func getPolicyGatewayRouteTableFilters(d *schema.ResourceData) (*string, *string) {
+	var edgePath, networkPrefix *string
+	if value := d.Get("edge_node_path").(string); value != "" {
+		edgePath = &value
+	}
+	if value := d.Get("network_prefix").(string); value != "" {
+		networkPrefix = &value
+	}
+	return edgePath, networkPrefix
+}
+
+func dataSourceNsxtPolicyGatewayRoutingTableRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if !isT0 || gwID == "" {
+		return fmt.Errorf("Routing table is only supported for Tier-0 gateways, got %s", gwPath)
+	}
+
+	connector := getPolicyConnector(m)
+	client := tier_0s.NewRoutingTableClient(connector)
+	edgePath, networkPrefix := getPolicyGatewayRouteTableFilters(d)
+	result, err := client.List(gwID, nil, nil, nil, edgePath, nil, nil, networkPrefix, nil, nil, nil, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "Routing Table", gwID, err)
+	}
+
+	err = setPolicyGatewayRouteTableInSchema(d, result.Results)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
@@ -0,0 +1,44 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyGatewayRoutingTable_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_gateway_routing_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayRouteTableTemplate("nsxt_policy_gateway_routing_table"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "edge.#"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge.0.edge_node"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGatewayRouteTableTemplate(dataSourceType string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_tier0_gateway" "test" {
+  display_name = "%s"
+}
+
+data "%s" "test" {
+  gateway_path = data.nsxt_policy_tier0_gateway.test.path
+}`, getTier0RouterName(), dataSourceType)
+}
@@ -0,0 +1,197 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security/intrusion_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyIdsEventSeverityValues = []string{"Critical", "High", "Medium", "Low"}
+
+var policyIdsEventTrafficTypeValues = []string{
+	model.PolicyIdsEventsBySignature_TRAFFIC_TYPE_GATEWAY,
+	model.PolicyIdsEventsBySignature_TRAFFIC_TYPE_HOST,
+}
+
+func dataSourceNsxtPolicyIntrusionServiceEvents() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyIntrusionServiceEventsRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"start_time": {
+				Type:         schema.TypeString,
+				Description:  "Report events detected after this time, in RFC3339 format",
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Description:  "Report events detected before this time, in RFC3339 format",
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"severity": {
+				Type:        schema.TypeSet,
+				Description: "Report only events of these severities",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(policyIdsEventSeverityValues, false),
+				},
+			},
+			"traffic_type": {
+				Type:         schema.TypeString,
+				Description:  "Report only events detected on this type of traffic",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(policyIdsEventTrafficTypeValues, false),
+			},
+			"event": {
+				Type:        schema.TypeList,
+				Description: "Intrusions detected by IDS/IPS signatures, grouped by signature",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"signature_id": {
+							Type:        schema.TypeInt,
+							Description: "Signature ID of the detected intrusion",
+							Computed:    true,
+						},
+						"signature_name": {
+							Type:        schema.TypeString,
+							Description: "Signature name of the detected intrusion",
+							Computed:    true,
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Description: "Severity of the threat covered by the signature",
+							Computed:    true,
+						},
+						"count": {
+							Type:        schema.TypeInt,
+							Description: "Number of times the signature was detected",
+							Computed:    true,
+						},
+						"first_occurrence": {
+							Type:        schema.TypeString,
+							Description: "Time of the first detection, in RFC3339 format",
+							Computed:    true,
+						},
+						"ongoing": {
+							Type:        schema.TypeBool,
+							Description: "Whether the intrusion is ongoing",
+							Computed:    true,
+						},
+						"traffic_type": {
+							Type:        schema.TypeString,
+							Description: "Type of traffic the intrusion was detected on",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getPolicyIdsEventTimeFilter(d *schema.ResourceData, attr string) (*model.FilterRequest, error) {
+	value := d.Get(attr).(string)
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	fieldName := attr
+	filterValue := strconv.FormatInt(t.UnixMilli(), 10)
+	return &model.FilterRequest{
+		FieldNames: &fieldName,
+		Value:      &filterValue,
+	}, nil
+}
+
+// filterPolicyIdsEvents narrows down events by criteria that NSX does not filter on
+func filterPolicyIdsEvents(events []model.PolicyIdsEventsBySignature, severities []string, trafficType string) []model.PolicyIdsEventsBySignature {
+	var result []model.PolicyIdsEventsBySignature
+	for _, event := range events {
+		if len(severities) > 0 {
+			matched := false
+			for _, severity := range severities {
+				if event.Severity != nil && strings.EqualFold(*event.Severity, severity) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				continue
+			}
+		}
+		if trafficType != "" && (event.TrafficType == nil || *event.TrafficType != trafficType) {
+			continue
+		}
+		result = append(result, event)
+	}
+
+	return result
+}
+
+func dataSourceNsxtPolicyIntrusionServiceEventsRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := intrusion_services.NewIdsEventsClient(connector)
+
+	request := model.PolicyIdsEventDataRequest{}
+	for _, attr := range []string{"start_time", "end_time"} {
+		filter, err := getPolicyIdsEventTimeFilter(d, attr)
+		if err != nil {
+			return err
+		}
+		if filter != nil {
+			request.Filters = append(request.Filters, *filter)
+		}
+	}
+
+	result, err := client.Create(request, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "Intrusion Service Events", "", err)
+	}
+
+	severities := interface2StringList(d.Get("severity").(*schema.Set).List())
+	events := filterPolicyIdsEvents(result.Results, severities, d.Get("traffic_type").(string))
+
+	var eventList []map[string]interface{}
+	for _, event := range events {
+		elem := make(map[string]interface{})
+		elem["signature_id"] = event.SignatureId
+		elem["signature_name"] = event.SignatureName
+		elem["severity"] = event.Severity
+		elem["count"] = event.Count
+		if event.FirstOccurence != nil {
+			elem["first_occurrence"] = time.UnixMilli(*event.FirstOccurence).UTC().Format(time.RFC3339)
+		}
+		elem["ongoing"] = event.IsOngoing
+		elem["traffic_type"] = event.TrafficType
+		eventList = append(eventList, elem)
+	}
+
+	err = d.Set("event", eventList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
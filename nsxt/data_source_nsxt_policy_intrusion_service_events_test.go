@@ -0,0 +1,69 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestAccDataSourceNsxtPolicyIntrusionServiceEvents_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_intrusion_service_events.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.1.0")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "nsxt_policy_intrusion_service_events" "test" {
+  start_time = "2020-01-01T00:00:00Z"
+  severity   = ["Critical", "High"]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterPolicyIdsEvents(t *testing.T) {
+	critical := "Critical"
+	low := "Low"
+	host := model.PolicyIdsEventsBySignature_TRAFFIC_TYPE_HOST
+	gateway := model.PolicyIdsEventsBySignature_TRAFFIC_TYPE_GATEWAY
+	events := []model.PolicyIdsEventsBySignature{
+		{Severity: &critical, TrafficType: &host},
+		{Severity: &low, TrafficType: &host},
+		{Severity: &critical, TrafficType: &gateway},
+		{},
+	}
+
+	cases := []struct {
+		severities  []string
+		trafficType string
+		expected    int
+	}{
+		{nil, "", 4},
+		{[]string{"Critical"}, "", 2},
+		{[]string{"critical", "Low"}, "", 3},
+		{nil, host, 2},
+		{[]string{"Critical"}, gateway, 1},
+		{[]string{"Medium"}, "", 0},
+	}
+
+	for _, c := range cases {
+		result := filterPolicyIdsEvents(events, c.severities, c.trafficType)
+		if len(result) != c.expected {
+			t.Errorf("Expected %d events for severities %v and traffic type %q, got %d", c.expected, c.severities, c.trafficType, len(result))
+		}
+	}
+}
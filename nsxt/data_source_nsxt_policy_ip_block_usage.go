@@ -0,0 +1,321 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra"
+	ippools "github.com/vmware/terraform-provider-nsxt/api/infra/ip_pools"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func dataSourceNsxtPolicyIPBlockUsage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyIPBlockUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":      getDataSourceIDSchema(),
+			"context": getContextSchema(false, false, false),
+			"block_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of IP block",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"prefix_length": {
+				Type:         schema.TypeInt,
+				Description:  "Prefix length of free CIDRs to compute",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 128),
+			},
+			"free_cidr_count": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum number of free CIDRs to compute",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 256),
+			},
+			"cidr": {
+				Type:        schema.TypeString,
+				Description: "CIDR of the IP block",
+				Computed:    true,
+			},
+			"total_ips": {
+				Type:        schema.TypeString,
+				Description: "Total number of IP addresses in the block",
+				Computed:    true,
+			},
+			"allocated_ips": {
+				Type:        schema.TypeString,
+				Description: "Number of IP addresses in the block allocated to IP pool subnets",
+				Computed:    true,
+			},
+			"subnet": {
+				Type:        schema.TypeList,
+				Description: "IP pool subnets allocated from the block",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the subnet",
+							Computed:    true,
+						},
+						"pool_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the IP pool the subnet belongs to",
+							Computed:    true,
+						},
+						"cidr": {
+							Type:        schema.TypeString,
+							Description: "CIDR of the subnet",
+							Computed:    true,
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Description: "Number of IP addresses in the subnet",
+							Computed:    true,
+						},
+						"owner": {
+							Type:        schema.TypeString,
+							Description: "User that created the subnet",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"free_cidrs": {
+				Type:        schema.TypeList,
+				Description: "Free CIDRs of requested prefix length, in address order",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func listPolicyIPBlockSubnets(context utl.SessionContext, connector client.Connector, blockPath string) ([]model.IpAddressPoolBlockSubnet, error) {
+	poolClient := infra.NewIpPoolsClient(context, connector)
+	subnetClient := ippools.NewIpSubnetsClient(context, connector)
+	if poolClient == nil || subnetClient == nil {
+		return nil, policyResourceNotSupportedError()
+	}
+
+	var pools []model.IpAddressPool
+	var cursor *string
+	for {
+		result, err := poolClient.List(cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, result.Results...)
+		if result.Cursor == nil || *result.Cursor == "" || len(result.Results) == 0 {
+			break
+		}
+		cursor = result.Cursor
+	}
+
+	converter := bindings.NewTypeConverter()
+	var subnets []model.IpAddressPoolBlockSubnet
+	for _, pool := range pools {
+		cursor = nil
+		for {
+			result, err := subnetClient.List(*pool.Id, cursor, nil, nil, nil, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			for _, subnetData := range result.Results {
+				baseObj, errs := converter.ConvertToGolang(subnetData, model.IpAddressPoolSubnetBindingType())
+				if len(errs) > 0 {
+					return nil, errs[0]
+				}
+				if baseObj.(model.IpAddressPoolSubnet).ResourceType != "IpAddressPoolBlockSubnet" {
+					continue
+				}
+				obj, errs := converter.ConvertToGolang(subnetData, model.IpAddressPoolBlockSubnetBindingType())
+				if len(errs) > 0 {
+					return nil, errs[0]
+				}
+				subnet := obj.(model.IpAddressPoolBlockSubnet)
+				if subnet.IpBlockPath != nil && *subnet.IpBlockPath == blockPath {
+					subnets = append(subnets, subnet)
+				}
+			}
+			if result.Cursor == nil || *result.Cursor == "" || len(result.Results) == 0 {
+				break
+			}
+			cursor = result.Cursor
+		}
+	}
+
+	return subnets, nil
+}
+
+type ipRange struct {
+	start *big.Int
+	end   *big.Int
+}
+
+func getIPRangeFromCidr(cidr string) (*ipRange, int, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, 0, err
+	}
+	ones, bits := ipNet.Mask.Size()
+	ip := ipNet.IP.To16()
+	if bits == 32 {
+		ip = ipNet.IP.To4()
+	}
+	start := new(big.Int).SetBytes(ip)
+	size := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+	end := new(big.Int).Sub(new(big.Int).Add(start, size), big.NewInt(1))
+	return &ipRange{start: start, end: end}, bits, nil
+}
+
+func getCidrFromBigInt(value *big.Int, bits int, prefixLength int) string {
+	ip := make(net.IP, bits/8)
+	value.FillBytes(ip)
+	return fmt.Sprintf("%s/%d", ip.String(), prefixLength)
+}
+
+// Compute up to count free CIDRs with given prefix length within block CIDR,
+// skipping any CIDR that overlaps with used CIDRs
+func getFreeCidrsInBlock(blockCidr string, usedCidrs []string, prefixLength int, count int) ([]string, error) {
+	block, bits, err := getIPRangeFromCidr(blockCidr)
+	if err != nil {
+		return nil, err
+	}
+	_, blockNet, _ := net.ParseCIDR(blockCidr)
+	blockPrefix, _ := blockNet.Mask.Size()
+	if prefixLength < blockPrefix || prefixLength > bits {
+		return nil, fmt.Errorf("prefix length %d is not valid for block %s", prefixLength, blockCidr)
+	}
+
+	var used []*ipRange
+	for _, cidr := range usedCidrs {
+		r, usedBits, err := getIPRangeFromCidr(cidr)
+		if err != nil || usedBits != bits {
+			continue
+		}
+		used = append(used, r)
+	}
+	sort.Slice(used, func(i, j int) bool {
+		return used[i].start.Cmp(used[j].start) < 0
+	})
+	// sentinel range right after the block end
+	afterBlock := new(big.Int).Add(block.end, big.NewInt(1))
+	used = append(used, &ipRange{start: afterBlock, end: block.end})
+
+	one := big.NewInt(1)
+	step := new(big.Int).Lsh(one, uint(bits-prefixLength))
+	var result []string
+	cursor := new(big.Int).Set(block.start)
+	for _, r := range used {
+		for len(result) < count {
+			// align candidate to prefix boundary
+			candidate := new(big.Int).Add(cursor, new(big.Int).Sub(step, one))
+			candidate.Div(candidate, step).Mul(candidate, step)
+			candidateEnd := new(big.Int).Add(candidate, new(big.Int).Sub(step, one))
+			if candidateEnd.Cmp(r.start) >= 0 || candidateEnd.Cmp(block.end) > 0 {
+				break
+			}
+			result = append(result, getCidrFromBigInt(candidate, bits, prefixLength))
+			cursor = new(big.Int).Add(candidateEnd, one)
+		}
+		if len(result) >= count {
+			break
+		}
+		next := new(big.Int).Add(r.end, one)
+		if next.Cmp(cursor) > 0 {
+			cursor = next
+		}
+	}
+
+	return result, nil
+}
+
+func dataSourceNsxtPolicyIPBlockUsageRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+
+	blockPath := d.Get("block_path").(string)
+	blockID := getPolicyIDFromPath(blockPath)
+	if blockID == "" {
+		return fmt.Errorf("block_path %s is not valid", blockPath)
+	}
+
+	client := infra.NewIpBlocksClient(context, connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	block, err := client.Get(blockID, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "IP Block", blockID, err)
+	}
+	if block.Cidr == nil {
+		return fmt.Errorf("CIDR is not set for IP Block %s", blockID)
+	}
+
+	blockRange, _, err := getIPRangeFromCidr(*block.Cidr)
+	if err != nil {
+		return err
+	}
+	totalIPs := new(big.Int).Sub(blockRange.end, blockRange.start)
+	totalIPs.Add(totalIPs, big.NewInt(1))
+
+	subnets, err := listPolicyIPBlockSubnets(context, connector, blockPath)
+	if err != nil {
+		return handleDataSourceReadError(d, "IP Block Subnets", blockID, err)
+	}
+
+	allocatedIPs := new(big.Int)
+	var usedCidrs []string
+	var subnetList []map[string]interface{}
+	for _, subnet := range subnets {
+		elem := make(map[string]interface{})
+		elem["path"] = subnet.Path
+		elem["pool_path"] = subnet.ParentPath
+		elem["cidr"] = subnet.Cidr
+		elem["size"] = subnet.Size
+		elem["owner"] = subnet.CreateUser
+		subnetList = append(subnetList, elem)
+
+		if subnet.Cidr != nil {
+			usedCidrs = append(usedCidrs, *subnet.Cidr)
+			if r, _, err := getIPRangeFromCidr(*subnet.Cidr); err == nil {
+				allocatedIPs.Add(allocatedIPs, new(big.Int).Sub(r.end, r.start))
+				allocatedIPs.Add(allocatedIPs, big.NewInt(1))
+			}
+		}
+	}
+
+	var freeCidrs []string
+	if prefixLength, ok := d.GetOk("prefix_length"); ok {
+		freeCidrs, err = getFreeCidrsInBlock(*block.Cidr, usedCidrs, prefixLength.(int), d.Get("free_cidr_count").(int))
+		if err != nil {
+			return err
+		}
+	}
+
+	d.Set("cidr", block.Cidr)
+	d.Set("total_ips", totalIPs.String())
+	d.Set("allocated_ips", allocatedIPs.String())
+	d.Set("subnet", subnetList)
+	d.Set("free_cidrs", freeCidrs)
+
+	d.SetId(blockID)
+	return nil
+}
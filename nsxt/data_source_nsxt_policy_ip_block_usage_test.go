@@ -0,0 +1,103 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+type freeCidrTest struct {
+	block        string
+	used         []string
+	prefixLength int
+	count        int
+	expected     []string
+}
+
+func TestGetFreeCidrsInBlock(t *testing.T) {
+
+	testData := []freeCidrTest{
+		{
+			block:        "10.0.0.0/24",
+			used:         []string{"10.0.0.128/27", "10.0.0.0/26"},
+			prefixLength: 26,
+			count:        3,
+			expected:     []string{"10.0.0.64/26", "10.0.0.192/26"},
+		},
+		{
+			block:        "10.0.0.0/24",
+			used:         []string{"10.0.0.4/30"},
+			prefixLength: 28,
+			count:        2,
+			expected:     []string{"10.0.0.16/28", "10.0.0.32/28"},
+		},
+		{
+			block:        "10.0.0.0/24",
+			used:         nil,
+			prefixLength: 24,
+			count:        1,
+			expected:     []string{"10.0.0.0/24"},
+		},
+		{
+			block:        "10.0.0.0/24",
+			used:         []string{"10.0.0.0/24"},
+			prefixLength: 30,
+			count:        1,
+			expected:     nil,
+		},
+		{
+			block:        "2001:db8::/48",
+			used:         []string{"2001:db8::/64", "10.0.0.0/24"},
+			prefixLength: 64,
+			count:        1,
+			expected:     []string{"2001:db8:0:1::/64"},
+		},
+	}
+
+	for _, test := range testData {
+		cidrs, err := getFreeCidrsInBlock(test.block, test.used, test.prefixLength, test.count)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, cidrs)
+	}
+
+	_, err := getFreeCidrsInBlock("10.0.0.0/24", nil, 16, 1)
+	assert.NotNil(t, err)
+}
+
+func TestAccDataSourceNsxtPolicyIPBlockUsage_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_ip_block_usage.test"
+	name := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPBlockUsageTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "cidr", "11.11.12.0/24"),
+					resource.TestCheckResourceAttr(testResourceName, "total_ips", "256"),
+					resource.TestCheckResourceAttr(testResourceName, "allocated_ips", "4"),
+					resource.TestCheckResourceAttr(testResourceName, "subnet.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "free_cidrs.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "free_cidrs.0", "11.11.12.16/28"),
+					resource.TestCheckResourceAttr(testResourceName, "free_cidrs.1", "11.11.12.32/28"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIPBlockUsageTemplate(name string) string {
+	return testAccNSXPolicyIPPoolBlockSubnetCreateMinimalTemplate(name, name) + `
+data "nsxt_policy_ip_block_usage" "test" {
+  block_path      = nsxt_policy_ip_block.block1.path
+  prefix_length   = 28
+  free_cidr_count = 2
+  depends_on      = [nsxt_policy_ip_pool_block_subnet.test]
+}`
+}
@@ -0,0 +1,156 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra"
+	ippools "github.com/vmware/terraform-provider-nsxt/api/infra/ip_pools"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func dataSourceNsxtPolicyIPPoolUsage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyIPPoolUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":      getDataSourceIDSchema(),
+			"context": getContextSchema(false, false, false),
+			"pool_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of IP pool",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"total_ips": {
+				Type:        schema.TypeInt,
+				Description: "Total number of IP addresses in the pool",
+				Computed:    true,
+			},
+			"available_ips": {
+				Type:        schema.TypeInt,
+				Description: "Number of available IP addresses in the pool",
+				Computed:    true,
+			},
+			"allocated_ip_allocations": {
+				Type:        schema.TypeInt,
+				Description: "Number of allocated IP addresses in the pool",
+				Computed:    true,
+			},
+			"requested_ip_allocations": {
+				Type:        schema.TypeInt,
+				Description: "Number of IP address allocation requests in the pool",
+				Computed:    true,
+			},
+			"allocation": {
+				Type:        schema.TypeList,
+				Description: "IP address allocations in the pool",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the allocation",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the allocation",
+							Computed:    true,
+						},
+						"allocation_ip": {
+							Type:        schema.TypeString,
+							Description: "Allocated IP address",
+							Computed:    true,
+						},
+						"owner": {
+							Type:        schema.TypeString,
+							Description: "User that created the allocation",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func listPolicyIPPoolAllocations(context utl.SessionContext, connector client.Connector, poolID string) ([]model.IpAddressAllocation, error) {
+	client := ippools.NewIpAllocationsClient(context, connector)
+	if client == nil {
+		return nil, policyResourceNotSupportedError()
+	}
+
+	var allocations []model.IpAddressAllocation
+	var cursor *string
+	for {
+		result, err := client.List(poolID, cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, result.Results...)
+		if result.Cursor == nil || *result.Cursor == "" || len(result.Results) == 0 {
+			return allocations, nil
+		}
+		cursor = result.Cursor
+	}
+}
+
+func dataSourceNsxtPolicyIPPoolUsageRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+
+	poolPath := d.Get("pool_path").(string)
+	poolID := getPolicyIDFromPath(poolPath)
+	if poolID == "" {
+		return fmt.Errorf("pool_path %s is not valid", poolPath)
+	}
+
+	client := infra.NewIpPoolsClient(context, connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	pool, err := client.Get(poolID)
+	if err != nil {
+		return handleDataSourceReadError(d, "IP Pool", poolID, err)
+	}
+
+	if pool.PoolUsage != nil {
+		d.Set("total_ips", pool.PoolUsage.TotalIps)
+		d.Set("available_ips", pool.PoolUsage.AvailableIps)
+		d.Set("allocated_ip_allocations", pool.PoolUsage.AllocatedIpAllocations)
+		d.Set("requested_ip_allocations", pool.PoolUsage.RequestedIpAllocations)
+	}
+
+	allocations, err := listPolicyIPPoolAllocations(context, connector, poolID)
+	if err != nil {
+		return handleDataSourceReadError(d, "IP Pool Allocations", poolID, err)
+	}
+
+	var allocationList []map[string]interface{}
+	for _, allocation := range allocations {
+		elem := make(map[string]interface{})
+		elem["path"] = allocation.Path
+		elem["display_name"] = allocation.DisplayName
+		elem["allocation_ip"] = allocation.AllocationIp
+		if allocation.AllocationIp == nil {
+			elem["allocation_ip"] = allocation.AllocatedIp
+		}
+		elem["owner"] = allocation.CreateUser
+		allocationList = append(allocationList, elem)
+	}
+
+	err = d.Set("allocation", allocationList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(poolID)
+	return nil
+}
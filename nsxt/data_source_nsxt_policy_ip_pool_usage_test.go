@@ -0,0 +1,48 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyIPPoolUsage_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_ip_pool_usage.test"
+	name := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPPoolUsageTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "total_ips"),
+					resource.TestCheckResourceAttr(testResourceName, "allocation.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "allocation.0.display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "allocation.0.allocation_ip", "12.12.12.11"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIPPoolUsageTemplate(name string) string {
+	return testAccNsxtPolicyIPAddressAllocationDependenciesTemplate(false) + fmt.Sprintf(`
+resource "nsxt_policy_ip_address_allocation" "test" {
+  display_name  = "%s"
+  allocation_ip = "12.12.12.11"
+  pool_path     = nsxt_policy_ip_pool.test.path
+  depends_on    = [data.nsxt_policy_realization_info.subnet_realization]
+}
+
+data "nsxt_policy_ip_pool_usage" "test" {
+  pool_path  = nsxt_policy_ip_pool.test.path
+  depends_on = [nsxt_policy_ip_address_allocation.test]
+}`, name)
+}
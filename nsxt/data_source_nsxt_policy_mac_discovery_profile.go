@@ -8,23 +8,5 @@ import (
 )
 
 func dataSourceNsxtPolicyMacDiscoveryProfile() *schema.Resource {
-	return &schema.Resource{
-		Read: dataSourceNsxtPolicyMacDiscoveryProfileRead,
-
-		Schema: map[string]*schema.Schema{
-			"id":           getDataSourceIDSchema(),
-			"display_name": getDataSourceDisplayNameSchema(),
-			"description":  getDataSourceDescriptionSchema(),
-			"path":         getPathSchema(),
-			"context":      getContextSchema(false, false, false),
-		},
-	}
-}
-
-func dataSourceNsxtPolicyMacDiscoveryProfileRead(d *schema.ResourceData, m interface{}) error {
-	_, err := policyDataSourceResourceRead(d, getPolicyConnector(m), getSessionContext(d, m), "MacDiscoveryProfile", nil)
-	if err != nil {
-		return err
-	}
-	return nil
+	return policyMacDiscoveryProfileResource.dataSource()
 }
@@ -0,0 +1,36 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNsxtPolicyMalwarePreventionProfile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyMalwarePreventionProfileRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":           getDataSourceIDSchema(),
+			"display_name": getDataSourceExtendedDisplayNameSchema(),
+			"description":  getDataSourceDescriptionSchema(),
+			"path":         getPathSchema(),
+		},
+	}
+}
+
+func dataSourceNsxtPolicyMalwarePreventionProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	_, err := policyDataSourceResourceRead(d, connector, getSessionContext(d, m), "MalwarePreventionProfile", nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyMalwarePreventionProfile_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "data.nsxt_policy_malware_prevention_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckMalwarePrevention(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyMalwarePreventionProfileTemplate(name, "SIGNATURE_BASED", `["EXECUTABLE"]`) + `
+data "nsxt_policy_malware_prevention_profile" "test" {
+  display_name = nsxt_policy_malware_prevention_profile.test.display_name
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttrPair(testResourceName, "path", "nsxt_policy_malware_prevention_profile.test", "path"),
+				),
+			},
+		},
+	})
+}
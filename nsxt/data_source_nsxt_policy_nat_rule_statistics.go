@@ -0,0 +1,206 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	t0nat "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/nat"
+	t0natrules "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/nat/nat_rules"
+	t1nat "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/nat"
+	t1natrules "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/nat/nat_rules"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyNATRuleStatistics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyNATRuleStatisticsRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"gateway_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of Tier-0 or Tier-1 gateway",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"rule_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of a single NAT rule to retrieve statistics for",
+				Optional:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Description: "Per rule statistics, aggregated across enforcement points",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the NAT rule",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the NAT rule",
+							Computed:    true,
+						},
+						"action": {
+							Type:        schema.TypeString,
+							Description: "Action of the NAT rule",
+							Computed:    true,
+						},
+						"active_sessions": {
+							Type:        schema.TypeInt,
+							Description: "Number of active sessions matching the rule",
+							Computed:    true,
+						},
+						"total_packets": {
+							Type:        schema.TypeInt,
+							Description: "Number of packets that hit the rule",
+							Computed:    true,
+						},
+						"total_bytes": {
+							Type:        schema.TypeInt,
+							Description: "Number of bytes that hit the rule",
+							Computed:    true,
+						},
+						"last_update_timestamp": {
+							Type:        schema.TypeInt,
+							Description: "Timestamp when the statistics were last updated",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func listPolicyNATRules(connector client.Connector, gwID string, isT0 bool, natType string) ([]model.PolicyNatRule, error) {
+	var rules []model.PolicyNatRule
+	var cursor *string
+	for {
+		var result model.PolicyNatRuleListResult
+		var err error
+		if isT0 {
+			result, err = t0nat.NewNatRulesClient(connector).List(gwID, natType, cursor, nil, nil, nil, nil, nil)
+		} else {
+			result, err = t1nat.NewNatRulesClient(connector).List(gwID, natType, cursor, nil, nil, nil, nil, nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, result.Results...)
+		if result.Cursor == nil || *result.Cursor == "" || len(result.Results) == 0 {
+			return rules, nil
+		}
+		cursor = result.Cursor
+	}
+}
+
+func getPolicyNATRuleStatistics(connector client.Connector, gwID string, isT0 bool, natType string, ruleID string) (map[string]interface{}, error) {
+	var result model.PolicyNatRuleStatisticsListResult
+	var err error
+	if isT0 {
+		result, err = t0natrules.NewStatisticsClient(connector).List(gwID, natType, ruleID, nil, nil, nil)
+	} else {
+		result, err = t1natrules.NewStatisticsClient(connector).List(gwID, natType, ruleID, nil, nil, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var activeSessions, totalPackets, totalBytes, lastUpdate int64
+	for _, perEP := range result.Results {
+		for _, stats := range perEP.RuleStatistics {
+			if stats.ActiveSessions != nil {
+				activeSessions += *stats.ActiveSessions
+			}
+			if stats.TotalPackets != nil {
+				totalPackets += *stats.TotalPackets
+			}
+			if stats.TotalBytes != nil {
+				totalBytes += *stats.TotalBytes
+			}
+			if stats.LastUpdateTimestamp != nil && *stats.LastUpdateTimestamp > lastUpdate {
+				lastUpdate = *stats.LastUpdateTimestamp
+			}
+		}
+	}
+
+	elem := make(map[string]interface{})
+	elem["active_sessions"] = activeSessions
+	elem["total_packets"] = totalPackets
+	elem["total_bytes"] = totalBytes
+	elem["last_update_timestamp"] = lastUpdate
+	return elem, nil
+}
+
+func dataSourceNsxtPolicyNATRuleStatisticsRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if gwID == "" {
+		return fmt.Errorf("gateway_path is not valid")
+	}
+
+	var rules []model.PolicyNatRule
+	rulePath := d.Get("rule_path").(string)
+	if rulePath != "" {
+		if !strings.HasPrefix(rulePath, gwPath+"/") {
+			return fmt.Errorf("rule_path %s does not belong to gateway %s", rulePath, gwPath)
+		}
+		natType, err := getParameterFromPolicyPath("/nat/", "/nat-rules/", rulePath)
+		if err != nil {
+			return err
+		}
+		ruleID := getPolicyIDFromPath(rulePath)
+		rule, err := getNsxtPolicyNATRuleByID(getSessionContext(d, m), connector, gwID, isT0, natType, ruleID)
+		if err != nil {
+			return handleDataSourceReadError(d, "NAT Rule", ruleID, err)
+		}
+		rules = append(rules, rule)
+	} else {
+		for _, natType := range []string{model.PolicyNat_NAT_TYPE_USER, model.PolicyNat_NAT_TYPE_NAT64} {
+			typeRules, err := listPolicyNATRules(connector, gwID, isT0, natType)
+			if err != nil {
+				return handleDataSourceReadError(d, "NAT Rules", gwID, err)
+			}
+			rules = append(rules, typeRules...)
+		}
+	}
+
+	var ruleList []map[string]interface{}
+	for _, rule := range rules {
+		natType := model.PolicyNat_NAT_TYPE_USER
+		if rule.Action != nil {
+			natType = getNatTypeByAction(*rule.Action)
+		}
+		elem, err := getPolicyNATRuleStatistics(connector, gwID, isT0, natType, *rule.Id)
+		if err != nil {
+			return handleDataSourceReadError(d, "NAT Rule Statistics", *rule.Id, err)
+		}
+		elem["path"] = rule.Path
+		elem["display_name"] = rule.DisplayName
+		elem["action"] = rule.Action
+		ruleList = append(ruleList, elem)
+	}
+
+	err := d.Set("rule", ruleList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
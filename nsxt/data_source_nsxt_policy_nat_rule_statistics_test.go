@@ -0,0 +1,44 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyNATRuleStatistics_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "data.nsxt_policy_nat_rule_statistics.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyNATRuleStatisticsTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action", "NAT64"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.0.path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.0.total_packets"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.0.active_sessions"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyNATRuleStatisticsTemplate(name string) string {
+	return testAccNsxtPolicyNATRuleTier0Nat64Template(name, "2001:db8:122:344::/96", "44.1.1.2") + `
+data "nsxt_policy_nat_rule_statistics" "test" {
+  gateway_path = nsxt_policy_tier0_gateway.test.path
+  rule_path    = nsxt_policy_nat_rule.test.path
+}`
+}
@@ -0,0 +1,225 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data/serializers/cleanjson"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+var policyObjectsFilterAttributes = []string{"query", "resource_type", "parent_path", "tag"}
+
+func dataSourceNsxtPolicyObjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":      getDataSourceIDSchema(),
+			"context": getContextSchema(false, false, false),
+			"query": {
+				Type:         schema.TypeString,
+				Description:  "NSX search query, combined with other filters",
+				Optional:     true,
+				AtLeastOneOf: policyObjectsFilterAttributes,
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Description:  "NSX resource type of objects",
+				Optional:     true,
+				AtLeastOneOf: policyObjectsFilterAttributes,
+			},
+			"parent_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of parent object",
+				Optional:     true,
+				ValidateFunc: validatePolicyPath(),
+				AtLeastOneOf: policyObjectsFilterAttributes,
+			},
+			"tag": {
+				Type:         schema.TypeSet,
+				Description:  "Tags that objects need to have, empty scope or tag match any value",
+				Optional:     true,
+				AtLeastOneOf: policyObjectsFilterAttributes,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tag": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Description: "Objects matching the search",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "Object ID",
+							Computed:    true,
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Object policy path",
+							Computed:    true,
+						},
+						"parent_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of parent object",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Object display name",
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "Object description",
+							Computed:    true,
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Description: "Object NSX resource type",
+							Computed:    true,
+						},
+						"tag": {
+							Type:        schema.TypeList,
+							Description: "Object tags",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"scope": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"tag": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"json": {
+							Type:        schema.TypeString,
+							Description: "Object as returned by NSX, in JSON format",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// buildPolicyObjectsQuery combines raw query with structured filters
+func buildPolicyObjectsQuery(d *schema.ResourceData) string {
+	var terms []string
+	if query := d.Get("query").(string); query != "" {
+		terms = append(terms, "("+query+")")
+	}
+	if resourceType := d.Get("resource_type").(string); resourceType != "" {
+		terms = append(terms, "resource_type:"+escapeSpecialCharacters(resourceType))
+	}
+	if parentPath := d.Get("parent_path").(string); parentPath != "" {
+		terms = append(terms, "parent_path:"+escapeSpecialCharacters(parentPath))
+	}
+	for _, tag := range getPolicyTagsFromSet(d.Get("tag").(*schema.Set)) {
+		if tag.Scope != nil && *tag.Scope != "" {
+			terms = append(terms, "tags.scope:"+escapeSpecialCharacters(*tag.Scope))
+		}
+		if tag.Tag != nil && *tag.Tag != "" {
+			terms = append(terms, "tags.tag:"+escapeSpecialCharacters(*tag.Tag))
+		}
+	}
+	terms = append(terms, "marked_for_delete:false")
+
+	return strings.Join(terms, " AND ")
+}
+
+// policyObjectHasTags checks that object carries each of the tags, since search
+// matches scopes and tags independently
+func policyObjectHasTags(obj model.PolicyResource, tags []map[string]interface{}) bool {
+	for _, required := range tags {
+		scope := required["scope"].(string)
+		value := required["tag"].(string)
+		found := false
+		for _, tag := range obj.Tags {
+			if (scope == "" || (tag.Scope != nil && *tag.Scope == scope)) && (value == "" || (tag.Tag != nil && *tag.Tag == value)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func dataSourceNsxtPolicyObjectsRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	query := buildPolicyObjectsQuery(d)
+
+	results, err := searchPolicyResources(connector, getSessionContext(d, m), query)
+	if err != nil {
+		return handleDataSourceReadError(d, "Policy Objects", query, err)
+	}
+
+	var requiredTags []map[string]interface{}
+	for _, tag := range d.Get("tag").(*schema.Set).List() {
+		requiredTags = append(requiredTags, tag.(map[string]interface{}))
+	}
+
+	converter := bindings.NewTypeConverter()
+	encoder := cleanjson.NewDataValueToJsonEncoder()
+	var itemList []map[string]interface{}
+	for _, result := range results {
+		dataValue, errs := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+		if len(errs) > 0 {
+			return errs[0]
+		}
+		obj := dataValue.(model.PolicyResource)
+		if !policyObjectHasTags(obj, requiredTags) {
+			continue
+		}
+
+		elem := make(map[string]interface{})
+		elem["id"] = obj.Id
+		elem["path"] = obj.Path
+		elem["parent_path"] = obj.ParentPath
+		elem["display_name"] = obj.DisplayName
+		elem["description"] = obj.Description
+		elem["resource_type"] = obj.ResourceType
+		var tagList []map[string]interface{}
+		for _, tag := range obj.Tags {
+			tagList = append(tagList, map[string]interface{}{"scope": tag.Scope, "tag": tag.Tag})
+		}
+		elem["tag"] = tagList
+		jsonValue, err := encoder.Encode(result)
+		if err != nil {
+			return err
+		}
+		elem["json"] = jsonValue
+		itemList = append(itemList, elem)
+	}
+
+	err = d.Set("items", itemList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
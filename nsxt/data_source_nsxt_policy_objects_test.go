@@ -0,0 +1,138 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNsxtPolicyObjects_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "data.nsxt_policy_objects.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyObjectsTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttr(testResourceName, "items.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.resource_type", "Group"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "items.0.json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyObjectsTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name = "%s"
+
+  tag {
+    scope = "objects-test"
+    tag   = "%s"
+  }
+}
+
+data "nsxt_policy_objects" "test" {
+  resource_type = "Group"
+
+  tag {
+    scope = "objects-test"
+    tag   = "%s"
+  }
+
+  depends_on = [nsxt_policy_group.test]
+}`, name, name, name)
+}
+
+func TestBuildPolicyObjectsQuery(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceNsxtPolicyObjects().Schema, map[string]interface{}{
+		"query":         "display_name:web* OR display_name:app*",
+		"resource_type": "Group",
+		"parent_path":   "/infra/domains/default",
+		"tag": []interface{}{
+			map[string]interface{}{"scope": "env", "tag": ""},
+		},
+	})
+
+	expected := "(display_name:web* OR display_name:app*) AND resource_type:Group AND parent_path:\\/infra\\/domains\\/default AND tags.scope:env AND marked_for_delete:false"
+	if query := buildPolicyObjectsQuery(d); query != expected {
+		t.Errorf("Unexpected query %s", query)
+	}
+}
+
+func TestPolicyObjectsRead(t *testing.T) {
+	server, provider := testSimulatorProvider(t, "", nil)
+
+	// more objects than fit in a single search page
+	groupCount := 1205
+	for i := 0; i < groupCount; i++ {
+		obj := map[string]interface{}{"display_name": fmt.Sprintf("group-%d", i)}
+		if i%100 == 0 {
+			obj["tags"] = []interface{}{map[string]interface{}{"scope": "env", "tag": "prod"}}
+		} else if i%100 == 1 {
+			obj["tags"] = []interface{}{
+				map[string]interface{}{"scope": "env", "tag": "dev"},
+				map[string]interface{}{"scope": "owner", "tag": "prod"},
+			}
+		}
+		if err := server.Put(fmt.Sprintf("/infra/domains/default/groups/g%d", i), obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := server.Put("/orgs/default/projects/dev/infra/domains/default/groups/p1", map[string]interface{}{"display_name": "group-p1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		config   map[string]interface{}
+		expected int
+	}{
+		{map[string]interface{}{"resource_type": "Group"}, groupCount},
+		{map[string]interface{}{"query": "display_name:group-1 OR display_name:group-2"}, 2},
+		{map[string]interface{}{
+			"resource_type": "Group",
+			"tag":           []interface{}{map[string]interface{}{"scope": "env", "tag": "prod"}},
+		}, 13},
+		{map[string]interface{}{
+			"parent_path": "/orgs/default/projects/dev/infra/domains/default",
+			"context":     []interface{}{map[string]interface{}{"project_id": "dev"}},
+		}, 1},
+	}
+
+	ds := dataSourceNsxtPolicyObjects()
+	for _, test := range tests {
+		d := schema.TestResourceDataRaw(t, ds.Schema, test.config)
+		if err := ds.Read(d, provider.Meta()); err != nil {
+			t.Fatal(err)
+		}
+		if count := d.Get("items.#").(int); count != test.expected {
+			t.Errorf("Expected %d objects for %v, got %d", test.expected, test.config, count)
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{"query": "id:g100"})
+	if err := ds.Read(d, provider.Meta()); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("items.0.path").(string) != "/infra/domains/default/groups/g100" || d.Get("items.0.tag.0.tag").(string) != "prod" ||
+		!strings.Contains(d.Get("items.0.json").(string), `"display_name":"group-100"`) {
+		t.Errorf("Unexpected item %v", d.Get("items.0"))
+	}
+}
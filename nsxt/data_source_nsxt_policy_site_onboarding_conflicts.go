@@ -0,0 +1,190 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/sites"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/sites/onboarding"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+func dataSourceNsxtPolicySiteOnboardingConflicts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySiteOnboardingConflictsRead,
+
+		Schema: map[string]*schema.Schema{
+			"site_path": getPolicyPathSchema(true, false, "Policy path of the site to check"),
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Prefix to evaluate for conflict resolution",
+			},
+			"suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Suffix to evaluate for conflict resolution",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Conflict status with given prefix and suffix",
+				Computed:    true,
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Description: "Total number of site objects across all features",
+				Computed:    true,
+			},
+			"total_conflict_count": {
+				Type:        schema.TypeInt,
+				Description: "Total number of site objects conflicting with global objects",
+				Computed:    true,
+			},
+			"conflict": {
+				Type:        schema.TypeList,
+				Description: "Per-feature conflict summary",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"feature": {
+							Type:        schema.TypeString,
+							Description: "Feature name",
+							Computed:    true,
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Description: "Resource type of conflicting objects",
+							Computed:    true,
+						},
+						"example_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of example conflicting object",
+							Computed:    true,
+						},
+						"conflict_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of conflicting objects within the feature",
+							Computed:    true,
+						},
+						"total_count": {
+							Type:        schema.TypeInt,
+							Description: "Total number of objects within the feature",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"incompatible_feature": {
+				Type:        schema.TypeList,
+				Description: "Features that are incompatible with Global Manager",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"feature": {
+							Type:        schema.TypeString,
+							Description: "Feature name",
+							Computed:    true,
+						},
+						"message": {
+							Type:        schema.TypeList,
+							Description: "Incompatibility details",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getSiteOnboardingConflictsFromFeatures(features []gm_model.FeatureConflictInfo) []map[string]interface{} {
+	var conflicts []map[string]interface{}
+	for _, f := range features {
+		if f.ConflictCount == nil || *f.ConflictCount == 0 {
+			continue
+		}
+		elem := make(map[string]interface{})
+		if f.Feature != nil {
+			elem["feature"] = f.Feature.Name
+			elem["resource_type"] = f.Feature.ResourceType
+			elem["example_path"] = f.Feature.Path
+		}
+		elem["conflict_count"] = f.ConflictCount
+		elem["total_count"] = f.TotalCount
+		conflicts = append(conflicts, elem)
+	}
+	return conflicts
+}
+
+func dataSourceNsxtPolicySiteOnboardingConflictsRead(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+
+	siteID, err := getSiteIDFromSitePath(d.Get("site_path").(string))
+	if err != nil {
+		return err
+	}
+
+	summaryClient := onboarding.NewFeatureSummaryClient(connector)
+	summary, err := summaryClient.Get(siteID)
+	if err != nil {
+		return handleDataSourceReadError(d, "SiteOnboardingConflicts", siteID, err)
+	}
+
+	prefix := d.Get("prefix").(string)
+	suffix := d.Get("suffix").(string)
+	request := gm_model.ConfigOnboardingConflictRequest{
+		SiteId: &siteID,
+	}
+	if prefix != "" {
+		request.Prefix = &prefix
+	}
+	if suffix != "" {
+		request.Suffix = &suffix
+	}
+	onboardingClient := sites.NewOnboardingClient(connector)
+	conflictStatus, err := onboardingClient.Checkconflict(siteID, request)
+	if err != nil {
+		return handleDataSourceReadError(d, "SiteOnboardingConflicts", siteID, err)
+	}
+
+	d.Set("status", conflictStatus.Status)
+	if summary.FeatureSummary != nil {
+		d.Set("total_count", summary.FeatureSummary.TotalCount)
+		d.Set("total_conflict_count", summary.FeatureSummary.TotalConflictCount)
+	}
+
+	conflicts := getSiteOnboardingConflictsFromFeatures(summary.InfraDescendants)
+	conflicts = append(conflicts, getSiteOnboardingConflictsFromFeatures(summary.FeatureDescendants)...)
+	d.Set("conflict", conflicts)
+
+	var incompatible []map[string]interface{}
+	for _, f := range summary.FeatureCompabilityData {
+		if f.Status == nil || *f.Status != gm_model.FeatureCompatibilityInfo_STATUS_INCOMPATIBLE {
+			continue
+		}
+		elem := make(map[string]interface{})
+		if f.Feature != nil {
+			elem["feature"] = f.Feature.Name
+		}
+		var messages []string
+		for _, detail := range f.Details {
+			if detail.StatusMessage != nil {
+				messages = append(messages, *detail.StatusMessage)
+			}
+		}
+		elem["message"] = messages
+		incompatible = append(incompatible, elem)
+	}
+	d.Set("incompatible_feature", incompatible)
+
+	d.SetId(siteID)
+
+	return nil
+}
@@ -0,0 +1,105 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNsxtPolicySiteOnboardingConflicts_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_site_onboarding_conflicts.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyGlobalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_SITE_NAME")
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySiteOnboardingConflictsReadTemplate(getTestSiteName()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "status"),
+					resource.TestCheckResourceAttrSet(testResourceName, "total_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySiteOnboardingConflictsReadTemplate(siteName string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_site" "test" {
+  display_name = "%s"
+}
+
+data "nsxt_policy_site_onboarding_conflicts" "test" {
+  site_path = data.nsxt_policy_site.test.path
+  prefix    = "tf-"
+}`, siteName)
+}
+
+func TestPolicySiteOnboardingConflictsRead(t *testing.T) {
+	server, provider := testSimulatorProvider(t, "", map[string]interface{}{"global_manager": true})
+
+	summary := map[string]interface{}{
+		"feature_summary": map[string]interface{}{"total_count": 12, "total_conflict_count": 2},
+		"infra_descendants": []interface{}{
+			map[string]interface{}{
+				"feature":        map[string]interface{}{"name": "Groups", "resource_type": "Group", "path": "/infra/domains/default/groups/web"},
+				"conflict_count": 2,
+				"total_count":    5,
+			},
+			map[string]interface{}{
+				"feature":        map[string]interface{}{"name": "Services", "resource_type": "Service"},
+				"conflict_count": 0,
+				"total_count":    7,
+			},
+		},
+		"feature_compability_data": []interface{}{
+			map[string]interface{}{
+				"feature": map[string]interface{}{"name": "IDS"},
+				"status":  "INCOMPATIBLE",
+				"details": []interface{}{map[string]interface{}{"status_message": "IDS is not supported"}},
+			},
+			map[string]interface{}{
+				"feature": map[string]interface{}{"name": "DFW"},
+				"status":  "COMPATIBLE",
+			},
+		},
+	}
+	if err := server.Put("/global-infra/sites/paris/onboarding/feature-summary", summary); err != nil {
+		t.Fatal(err)
+	}
+	if err := server.Put("/global-infra/sites/paris/onboarding", map[string]interface{}{"status": "CONFLICT_DETECTED"}); err != nil {
+		t.Fatal(err)
+	}
+
+	ds := dataSourceNsxtPolicySiteOnboardingConflicts()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"site_path": "/global-infra/sites/paris",
+	})
+	if err := ds.Read(d, provider.Meta()); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "paris" || d.Get("status").(string) != "CONFLICT_DETECTED" {
+		t.Errorf("Unexpected conflict state: id %s, status %s", d.Id(), d.Get("status"))
+	}
+	if d.Get("total_count").(int) != 12 || d.Get("total_conflict_count").(int) != 2 {
+		t.Errorf("Unexpected totals %v/%v", d.Get("total_count"), d.Get("total_conflict_count"))
+	}
+	if d.Get("conflict.#").(int) != 1 || d.Get("conflict.0.feature").(string) != "Groups" || d.Get("conflict.0.conflict_count").(int) != 2 {
+		t.Errorf("Unexpected conflicts %v", d.Get("conflict"))
+	}
+	if d.Get("incompatible_feature.#").(int) != 1 || d.Get("incompatible_feature.0.message.0").(string) != "IDS is not supported" {
+		t.Errorf("Unexpected incompatible features %v", d.Get("incompatible_feature"))
+	}
+}
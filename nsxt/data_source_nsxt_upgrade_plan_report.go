@@ -0,0 +1,347 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/upgrade"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/upgrade/plan"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
+)
+
+var (
+	// Default upgrade duration of a single upgrade unit in minutes, used for estimation only.
+	// NSX does not report expected upgrade durations, hence these are not derived from NSX.
+	defaultEdgeUnitUpgradeDuration = 15
+	defaultHostUnitUpgradeDuration = 30
+	defaultMpUnitUpgradeDuration   = 60
+)
+
+func dataSourceNsxtUpgradePlanReport() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtUpgradePlanReportRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"upgrade_prepare_id": {
+				Type:        schema.TypeString,
+				Description: "ID of corresponding nsxt_upgrade_prepare resource",
+				Required:    true,
+			},
+			"run_prechecks": {
+				Type:        schema.TypeBool,
+				Description: "Execute upgrade prechecks before generating the report",
+				Optional:    true,
+				Default:     false,
+			},
+			"precheck_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Timeout for executing upgrade prechecks in seconds",
+				Optional:     true,
+				Default:      precheckTimeout,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"edge_unit_duration": {
+				Type:         schema.TypeInt,
+				Description:  "Estimated upgrade duration of a single edge in minutes",
+				Optional:     true,
+				Default:      defaultEdgeUnitUpgradeDuration,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"host_unit_duration": {
+				Type:         schema.TypeInt,
+				Description:  "Estimated upgrade duration of a single host in minutes",
+				Optional:     true,
+				Default:      defaultHostUnitUpgradeDuration,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"mp_unit_duration": {
+				Type:         schema.TypeInt,
+				Description:  "Estimated upgrade duration of a single manager node in minutes",
+				Optional:     true,
+				Default:      defaultMpUnitUpgradeDuration,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"target_version": {
+				Type:        schema.TypeString,
+				Description: "Target upgrade version",
+				Computed:    true,
+			},
+			"estimated_duration": {
+				Type:        schema.TypeInt,
+				Description: "Rough estimate of the whole upgrade duration in minutes, based on configured unit durations",
+				Computed:    true,
+			},
+			"group": {
+				Type:        schema.TypeList,
+				Description: "Upgrade unit groups in upgrade order",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Description: "Component type",
+							Computed:    true,
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of upgrade unit group",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Name of upgrade unit group",
+							Computed:    true,
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "Flag to indicate whether upgrade of this group is enabled or not",
+							Computed:    true,
+						},
+						"parallel": {
+							Type:        schema.TypeBool,
+							Description: "Upgrade method to specify whether the upgrade is to be performed in parallel or serially",
+							Computed:    true,
+						},
+						"pause_after_each_upgrade_unit": {
+							Type:        schema.TypeBool,
+							Description: "Flag to indicate whether upgrade should be paused after upgrade of each upgrade-unit",
+							Computed:    true,
+						},
+						"upgrade_unit_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of upgrade units in the group",
+							Computed:    true,
+						},
+						"estimated_duration": {
+							Type:        schema.TypeInt,
+							Description: "Rough estimate of the group upgrade duration in minutes, based on configured unit durations",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"precheck": {
+				Type:        schema.TypeList,
+				Description: "Failed and warning upgrade prechecks",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of failed precheck",
+							Computed:    true,
+						},
+						"component_type": {
+							Type:        schema.TypeString,
+							Description: "Component type of the precheck",
+							Computed:    true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Description: "Type of the precheck failure (warning or error)",
+							Computed:    true,
+						},
+						"origin_name": {
+							Type:        schema.TypeString,
+							Description: "Name of the node the failure originates from",
+							Computed:    true,
+						},
+						"group_name": {
+							Type:        schema.TypeString,
+							Description: "Name of the upgrade unit group the failure originates from",
+							Computed:    true,
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Description: "Message of the failed precheck",
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "Generic description of the precheck, as listed in NSX upgrade checks info",
+							Computed:    true,
+						},
+						"needs_ack": {
+							Type:        schema.TypeBool,
+							Description: "Boolean value which identifies if acknowledgement is required for the precheck",
+							Computed:    true,
+						},
+						"acked": {
+							Type:        schema.TypeBool,
+							Description: "Boolean value which identifies if precheck has been acknowledged",
+							Computed:    true,
+						},
+						"needs_resolve": {
+							Type:        schema.TypeBool,
+							Description: "Boolean value identifies if resolution is required for the precheck",
+							Computed:    true,
+						},
+						"resolution_status": {
+							Type:        schema.TypeString,
+							Description: "The resolution status of the precheck failure",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Estimate upgrade duration of a group in minutes, based on the number of units and the upgrade method
+func getUpgradeGroupEstimatedDuration(unitCount int, unitDuration int, parallel bool) int {
+	if unitCount == 0 {
+		return 0
+	}
+	if parallel {
+		return unitDuration
+	}
+	return unitCount * unitDuration
+}
+
+// Estimate upgrade duration of a component in minutes, based on its group durations and the upgrade method
+func getUpgradeComponentEstimatedDuration(groupDurations []int, parallel bool) int {
+	total := 0
+	for _, duration := range groupDurations {
+		if parallel {
+			if duration > total {
+				total = duration
+			}
+		} else {
+			total += duration
+		}
+	}
+	return total
+}
+
+func getPrecheckDescriptions(checksInfoClient upgrade.UpgradeChecksInfoClient) (map[string]string, error) {
+	descriptions := make(map[string]string)
+	checkInfoResults, err := checksInfoClient.List(nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, checkInfo := range checkInfoResults.Results {
+		for _, ci := range checkInfo.PreUpgradeChecksInfo {
+			if ci.Id != nil && ci.Description != nil {
+				descriptions[*ci.Id] = *ci.Description
+			}
+		}
+	}
+	return descriptions, nil
+}
+
+func dataSourceNsxtUpgradePlanReportRead(d *schema.ResourceData, m interface{}) error {
+	// Validate that upgrade_prepare_id is actually from the nsxt_upgrade_prepare resource
+	upgradePrepareID := d.Get("upgrade_prepare_id").(string)
+	if !util.VerifyVerifiableID(upgradePrepareID, "nsxt_upgrade_prepare") {
+		return fmt.Errorf("value for upgrade_prepare_id is invalid: %s", upgradePrepareID)
+	}
+
+	connector := getPolicyConnector(m)
+	targetVersion, err := getTargetVersion(m)
+	if err != nil {
+		return fmt.Errorf("Error while reading upgrade target version: %v", err)
+	}
+
+	if d.Get("run_prechecks").(bool) {
+		err = executePreupgradeChecks(d, m)
+		if err != nil {
+			return fmt.Errorf("Error while executing upgrade prechecks: %v", err)
+		}
+	}
+
+	unitDurations := map[string]int{
+		edgeUpgradeGroup: d.Get("edge_unit_duration").(int),
+		hostUpgradeGroup: d.Get("host_unit_duration").(int),
+		mpUpgradeGroup:   d.Get("mp_unit_duration").(int),
+	}
+
+	groupClient := upgrade.NewUpgradeUnitGroupsClient(connector)
+	settingClient := plan.NewSettingsClient(connector)
+	var groups []map[string]interface{}
+	totalDuration := 0
+	for _, c := range getUpgradeComponentList(targetVersion) {
+		component := c
+		if component == finalizeUpgradeGroup {
+			continue
+		}
+		setting, err := settingClient.Get(component)
+		if err != nil {
+			return fmt.Errorf("Error while reading %s upgrade plan setting: %v", component, err)
+		}
+		groupList, err := groupClient.List(&component, nil, nil, nil, nil, nil, nil, nil)
+		if err != nil {
+			return fmt.Errorf("Error while reading %s upgrade unit groups: %v", component, err)
+		}
+
+		var groupDurations []int
+		for _, group := range groupList.Results {
+			unitCount := 0
+			if group.UpgradeUnitCount != nil {
+				unitCount = int(*group.UpgradeUnitCount)
+			}
+			parallel := group.Parallel != nil && *group.Parallel
+			duration := 0
+			if group.Enabled != nil && *group.Enabled {
+				duration = getUpgradeGroupEstimatedDuration(unitCount, unitDurations[component], parallel)
+			}
+			groupDurations = append(groupDurations, duration)
+
+			elem := make(map[string]interface{})
+			elem["type"] = component
+			elem["id"] = group.Id
+			elem["display_name"] = group.DisplayName
+			elem["enabled"] = group.Enabled
+			elem["parallel"] = group.Parallel
+			elem["pause_after_each_upgrade_unit"] = group.PauseAfterEachUpgradeUnit
+			elem["upgrade_unit_count"] = unitCount
+			elem["estimated_duration"] = duration
+			groups = append(groups, elem)
+		}
+		componentParallel := setting.Parallel != nil && *setting.Parallel
+		totalDuration += getUpgradeComponentEstimatedDuration(groupDurations, componentParallel)
+	}
+
+	precheckErrors, err := getPrecheckErrors(m, nil)
+	if err != nil {
+		return fmt.Errorf("Error while reading precheck failures: %v", err)
+	}
+	descriptions, err := getPrecheckDescriptions(upgrade.NewUpgradeChecksInfoClient(connector))
+	if err != nil {
+		return fmt.Errorf("Error while reading precheck descriptions: %v", err)
+	}
+	var prechecks []map[string]interface{}
+	for _, precheckError := range precheckErrors {
+		elem := make(map[string]interface{})
+		elem["id"] = precheckError.Id
+		elem["component_type"] = precheckError.ComponentType
+		elem["type"] = precheckError.Type_
+		elem["origin_name"] = precheckError.OriginName
+		elem["group_name"] = precheckError.GroupName
+		if precheckError.Message != nil {
+			elem["message"] = precheckError.Message.Message
+		}
+		if precheckError.Id != nil {
+			elem["description"] = descriptions[*precheckError.Id]
+		}
+		elem["needs_ack"] = precheckError.NeedsAck
+		elem["acked"] = precheckError.Acked
+		elem["needs_resolve"] = precheckError.NeedsResolve
+		elem["resolution_status"] = precheckError.ResolutionStatus
+		prechecks = append(prechecks, elem)
+	}
+
+	d.Set("target_version", targetVersion)
+	d.Set("estimated_duration", totalDuration)
+	d.Set("group", groups)
+	d.Set("precheck", prechecks)
+	d.SetId(newUUID())
+
+	return nil
+}
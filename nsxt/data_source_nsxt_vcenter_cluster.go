@@ -0,0 +1,116 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/fabric"
+)
+
+const vcClusterOriginType = "VC_Cluster"
+
+func dataSourceNsxtVcenterCluster() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtVcenterClusterRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"compute_manager_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the compute manager the cluster was discovered from",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the cluster in vCenter",
+			},
+			"compute_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cluster identifier in vCenter, to be used as compute_id for edge deployment",
+			},
+			"host": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Hosts of the cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the host in vCenter",
+						},
+						"host_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Host identifier in vCenter, to be used as host_id for edge deployment",
+						},
+						"discovered_node_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "External ID of the discovered node",
+						},
+						"ip_addresses": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "IP addresses of the host",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtVcenterClusterRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := fabric.NewComputeCollectionsClient(connector)
+
+	computeManagerID := d.Get("compute_manager_id").(string)
+	objName := d.Get("display_name").(string)
+	originType := vcClusterOriginType
+
+	objList, err := client.List(nil, nil, nil, &objName, nil, nil, nil, &computeManagerID, &originType, nil, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to read clusters of compute manager %s: %v", computeManagerID, err)
+	}
+	if len(objList.Results) == 0 {
+		return fmt.Errorf("cluster %s was not found in compute manager %s", objName, computeManagerID)
+	}
+	if len(objList.Results) > 1 {
+		return fmt.Errorf("found multiple clusters named %s in compute manager %s", objName, computeManagerID)
+	}
+	obj := objList.Results[0]
+	if obj.ExternalId == nil {
+		return fmt.Errorf("cluster %s in compute manager %s has no external ID", objName, computeManagerID)
+	}
+
+	nodeClient := fabric.NewDiscoveredNodesClient(connector)
+	nodeType := "HostNode"
+	nodeList, err := nodeClient.List(nil, nil, nil, nil, nil, nil, nil, nil, &nodeType, &computeManagerID, nil, obj.ExternalId, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to read hosts of cluster %s: %v", objName, err)
+	}
+	var hosts []map[string]interface{}
+	for _, node := range nodeList.Results {
+		elem := make(map[string]interface{})
+		elem["display_name"] = node.DisplayName
+		elem["host_id"] = node.CmLocalId
+		elem["discovered_node_id"] = node.ExternalId
+		elem["ip_addresses"] = node.IpAddresses
+		hosts = append(hosts, elem)
+	}
+
+	d.SetId(*obj.ExternalId)
+	d.Set("compute_id", obj.CmLocalId)
+	d.Set("host", hosts)
+
+	return nil
+}
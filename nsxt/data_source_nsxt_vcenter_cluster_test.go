@@ -0,0 +1,49 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtVcenterCluster_basic(t *testing.T) {
+	clusterName := getComputeCollectionName()
+	testResourceName := "data.nsxt_vcenter_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_COMPUTE_COLLECTION")
+			testAccEnvDefined(t, "NSXT_TEST_COMPUTE_MANAGER")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXVcenterClusterReadTemplate(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", clusterName),
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "compute_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "host.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXVcenterClusterReadTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_compute_manager" "test" {
+  display_name = "%s"
+}
+
+data "nsxt_vcenter_cluster" "test" {
+  compute_manager_id = data.nsxt_compute_manager.test.id
+  display_name       = "%s"
+}`, getComputeManagerName(), name)
+}
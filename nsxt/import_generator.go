@@ -0,0 +1,524 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+// importGeneratorResource maps NSX resource type to terraform resource that
+// can import it
+type importGeneratorResource struct {
+	tfType       string
+	resourceType string
+	// object resides under org rather than under infra
+	orgScoped bool
+}
+
+// importGeneratorOrgScoped lists resources that manage objects under org rather than under infra
+var importGeneratorOrgScoped = map[string]bool{
+	"nsxt_policy_project": true,
+}
+
+// importGeneratorExcluded lists importable policy resources that import generator
+// does not discover, thus not listed in policyResourceTypes
+var importGeneratorExcluded = map[string]bool{
+	// objects nested under gateways, segments, pools or other policy objects
+	"nsxt_policy_bgp_neighbor":                                 true,
+	"nsxt_policy_context_profile_custom_attribute":             true,
+	"nsxt_policy_dhcp_v4_static_binding":                       true,
+	"nsxt_policy_dhcp_v6_static_binding":                       true,
+	"nsxt_policy_distributed_flood_protection_profile_binding": true,
+	"nsxt_policy_evpn_config":                                  true,
+	"nsxt_policy_evpn_tenant":                                  true,
+	"nsxt_policy_evpn_tunnel_endpoint":                         true,
+	"nsxt_policy_firewall_exclude_list_member":                 true,
+	"nsxt_policy_gateway_community_list":                       true,
+	"nsxt_policy_gateway_dns_forwarder":                        true,
+	"nsxt_policy_gateway_flood_protection_profile_binding":     true,
+	"nsxt_policy_gateway_prefix_list":                          true,
+	"nsxt_policy_gateway_redistribution_config":                true,
+	"nsxt_policy_gateway_route_aggregation":                    true,
+	"nsxt_policy_gateway_route_map":                            true,
+	"nsxt_policy_ip_address_allocation":                        true,
+	"nsxt_policy_ip_pool_block_subnet":                         true,
+	"nsxt_policy_ip_pool_static_subnet":                        true,
+	"nsxt_policy_ipsec_vpn_local_endpoint":                     true,
+	"nsxt_policy_ipsec_vpn_service":                            true,
+	"nsxt_policy_ipsec_vpn_session":                            true,
+	"nsxt_policy_l2_vpn_service":                               true,
+	"nsxt_policy_l2_vpn_session":                               true,
+	"nsxt_policy_nat_rule":                                     true,
+	"nsxt_policy_ospf_area":                                    true,
+	"nsxt_policy_ospf_summary_address":                         true,
+	"nsxt_policy_security_policy_rule":                         true,
+	"nsxt_policy_shared_resource":                              true,
+	"nsxt_policy_static_route":                                 true,
+	"nsxt_policy_static_route_bfd_peer":                        true,
+	"nsxt_policy_tier0_gateway_gre_tunnel":                     true,
+	"nsxt_policy_tier0_gateway_ha_vip_config":                  true,
+	"nsxt_policy_tier0_gateway_interface":                      true,
+	"nsxt_policy_tier0_inter_vrf_routing":                      true,
+	"nsxt_policy_tier1_gateway_interface":                      true,
+	"nsxt_policy_vm_tags":                                      true,
+	// resource type is shared with another resource
+	"nsxt_policy_fixed_segment":             true,
+	"nsxt_policy_parent_security_policy":    true,
+	"nsxt_policy_predefined_gateway_policy": true,
+	"nsxt_policy_vlan_segment":              true,
+	// system, fabric or federation objects, and service insertion
+	"nsxt_policy_compute_sub_cluster":            true,
+	"nsxt_policy_firewall_identity_store":        true,
+	"nsxt_policy_global_manager":                 true,
+	"nsxt_policy_host_transport_node":            true,
+	"nsxt_policy_host_transport_node_collection": true,
+	"nsxt_policy_idfw_cluster":                   true,
+	"nsxt_policy_ldap_identity_source":           true,
+	"nsxt_policy_service_chain":                  true,
+	"nsxt_policy_service_profile":                true,
+	"nsxt_policy_service_reference":              true,
+	"nsxt_policy_share":                          true,
+	"nsxt_policy_site":                           true,
+	"nsxt_policy_site_onboarding":                true,
+	"nsxt_policy_transport_zone":                 true,
+	"nsxt_policy_user_management_role":           true,
+	"nsxt_policy_user_management_role_binding":   true,
+	"nsxt_policy_vni_pool":                       true,
+}
+
+// getImportGeneratorResources lists importable provider resources with known NSX resource
+// type. Scope (infra, project or VPC) is derived from resource context schema.
+func getImportGeneratorResources(resources map[string]*schema.Resource) []importGeneratorResource {
+	var tfTypes []string
+	for tfType, resource := range resources {
+		if _, ok := policyResourceTypes[tfType]; ok && resource.Importer != nil {
+			tfTypes = append(tfTypes, tfType)
+		}
+	}
+	sort.Strings(tfTypes)
+
+	var result []importGeneratorResource
+	for _, tfType := range tfTypes {
+		result = append(result, importGeneratorResource{
+			tfType:       tfType,
+			resourceType: policyResourceTypes[tfType],
+			orgScoped:    importGeneratorOrgScoped[tfType],
+		})
+	}
+	return result
+}
+
+type importGeneratorObject struct {
+	tfType   string
+	name     string
+	path     string
+	importID string
+	resource *schema.Resource
+	data     *schema.ResourceData
+}
+
+func (o *importGeneratorObject) address() string {
+	return fmt.Sprintf("%s.%s", o.tfType, o.name)
+}
+
+type importGenerator struct {
+	m         interface{}
+	connector client.Connector
+	resources map[string]*schema.Resource
+	objects   []*importGeneratorObject
+	// names in use per terraform resource type
+	names map[string]map[string]bool
+	// terraform address by policy path, used to replace path values with references
+	addresses map[string]string
+}
+
+// GenerateImportConfig discovers policy objects on NSX manager configured with
+// NSXT_* environment variables, and writes import blocks along with matching
+// resource configuration to w
+func GenerateImportConfig(w io.Writer) error {
+	provider := Provider()
+	diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{}))
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			return fmt.Errorf("Failed to configure provider: %s %s", d.Summary, d.Detail)
+		}
+	}
+
+	return generateImportConfig(w, provider)
+}
+
+func generateImportConfig(w io.Writer, provider *schema.Provider) error {
+	g := importGenerator{
+		m:         provider.Meta(),
+		connector: getPolicyConnector(provider.Meta()),
+		resources: provider.ResourcesMap,
+		names:     make(map[string]map[string]bool),
+		addresses: make(map[string]string),
+	}
+
+	contexts, err := g.listContexts()
+	if err != nil {
+		return err
+	}
+	for _, sessionContext := range contexts {
+		for _, r := range getImportGeneratorResources(g.resources) {
+			if err := g.discover(sessionContext, r); err != nil {
+				return err
+			}
+		}
+	}
+
+	config := strings.TrimSuffix(g.render(), "\n")
+	_, err = w.Write(hclwrite.Format([]byte(config)))
+	return err
+}
+
+// listContexts returns infra context followed by project and VPC contexts
+func (g *importGenerator) listContexts() ([]utl.SessionContext, error) {
+	if isPolicyGlobalManager(g.m) {
+		return []utl.SessionContext{{ClientType: utl.Global}}, nil
+	}
+
+	contexts := []utl.SessionContext{{ClientType: utl.Local}}
+	projects, err := g.search(utl.SessionContext{ClientType: utl.Local}, importGeneratorResource{resourceType: "Project", orgScoped: true})
+	if err != nil {
+		return nil, err
+	}
+	for _, project := range projects {
+		// default project objects reside under /infra
+		if *project.Id == "default" {
+			continue
+		}
+		projectContext := utl.SessionContext{ClientType: utl.Multitenancy, ProjectID: *project.Id}
+		contexts = append(contexts, projectContext)
+
+		vpcs, err := g.search(projectContext, importGeneratorResource{resourceType: "Vpc"})
+		if err != nil {
+			return nil, err
+		}
+		for _, vpc := range vpcs {
+			contexts = append(contexts, utl.SessionContext{ClientType: utl.VPC, ProjectID: *project.Id, VPCID: *vpc.Id})
+		}
+	}
+
+	return contexts, nil
+}
+
+// search lists user owned objects of given type that reside directly in given context
+func (g *importGenerator) search(sessionContext utl.SessionContext, r importGeneratorResource) ([]model.PolicyResource, error) {
+	query := fmt.Sprintf("resource_type:%s AND marked_for_delete:false", r.resourceType)
+	var results []*data.StructValue
+	var err error
+	switch {
+	case r.orgScoped:
+		results, err = searchLM(g.connector, query)
+	case sessionContext.ClientType == utl.Local:
+		results, err = searchLMPolicyResources(g.connector, query)
+	case sessionContext.ClientType == utl.Global:
+		results, err = searchGMPolicyResources(g.connector, query)
+	default:
+		results, err = searchMultitenancyResources(g.connector, sessionContext, query)
+	}
+	if err != nil {
+		return nil, logAPIError(fmt.Sprintf("Error searching %s objects", r.resourceType), err)
+	}
+
+	projectPrefix := fmt.Sprintf("/orgs/%s/projects/%s/", utl.DefaultOrgID, sessionContext.ProjectID)
+	vpcPrefix := projectPrefix + "vpcs/"
+	converter := bindings.NewTypeConverter()
+	var objects []model.PolicyResource
+	for _, result := range results {
+		dataValue, errs := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+		if len(errs) > 0 {
+			return nil, errs[0]
+		}
+		obj := dataValue.(model.PolicyResource)
+		if obj.Id == nil || obj.Path == nil || obj.ResourceType == nil || *obj.ResourceType != r.resourceType {
+			continue
+		}
+		if (obj.SystemOwned != nil && *obj.SystemOwned) || (obj.CreateUser != nil && *obj.CreateUser == "system") {
+			continue
+		}
+		// Project search matches VPC objects as well
+		if sessionContext.ClientType == utl.Multitenancy && strings.HasPrefix(*obj.Path, vpcPrefix) {
+			continue
+		}
+		if sessionContext.ClientType == utl.VPC && !strings.HasPrefix(*obj.Path, vpcPrefix+sessionContext.VPCID+"/") {
+			continue
+		}
+		objects = append(objects, obj)
+	}
+
+	sort.Slice(objects, func(i, j int) bool { return *objects[i].Path < *objects[j].Path })
+	return objects, nil
+}
+
+// resourceSupportsContext checks whether terraform resource can manage objects
+// in given context, based on its context schema
+func resourceSupportsContext(resource *schema.Resource, sessionContext utl.SessionContext) bool {
+	contextSchema, ok := resource.Schema["context"]
+	if !ok {
+		return sessionContext.ClientType == utl.Local || sessionContext.ClientType == utl.Global
+	}
+	_, isVPC := contextSchema.Elem.(*schema.Resource).Schema["vpc_id"]
+	switch sessionContext.ClientType {
+	case utl.Local, utl.Global:
+		return !isVPC && !contextSchema.Required
+	case utl.Multitenancy:
+		return !isVPC
+	case utl.VPC:
+		return isVPC
+	}
+	return false
+}
+
+func (g *importGenerator) discover(sessionContext utl.SessionContext, r importGeneratorResource) error {
+	resource, ok := g.resources[r.tfType]
+	if !ok || resource.Importer == nil || !resourceSupportsContext(resource, sessionContext) {
+		return nil
+	}
+	if r.orgScoped && sessionContext.ClientType != utl.Local {
+		return nil
+	}
+
+	objects, err := g.search(sessionContext, r)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objects {
+		importID := *obj.Path
+		d, err := importGeneratorImportState(resource, importID, g.m)
+		if err == nil && d.Id() == importID {
+			// importer expects object ID rather than policy path
+			importID = *obj.Id
+			d, err = importGeneratorImportState(resource, importID, g.m)
+		}
+		if err == nil {
+			err = importGeneratorRead(resource, d, g.m)
+		}
+		if err != nil {
+			log.Printf("[WARNING] Skipping %s %s: %v", r.tfType, *obj.Path, err)
+			continue
+		}
+		if d.Id() == "" {
+			continue
+		}
+
+		name := g.uniqueName(r.tfType, obj)
+		o := &importGeneratorObject{
+			tfType:   r.tfType,
+			name:     name,
+			path:     *obj.Path,
+			importID: importID,
+			resource: resource,
+			data:     d,
+		}
+		g.objects = append(g.objects, o)
+		g.addresses[o.path] = o.address()
+	}
+
+	return nil
+}
+
+// importGeneratorImportState runs resource importer, same as terraform import would
+func importGeneratorImportState(resource *schema.Resource, importID string, m interface{}) (*schema.ResourceData, error) {
+	d := resource.Data(nil)
+	d.SetId(importID)
+	if resource.Importer.State == nil {
+		return d, nil
+	}
+	imported, err := resource.Importer.State(d, m)
+	if err != nil {
+		return nil, err
+	}
+	if len(imported) > 0 {
+		d = imported[0]
+	}
+	return d, nil
+}
+
+// importGeneratorRead reads imported object into resource data
+func importGeneratorRead(resource *schema.Resource, d *schema.ResourceData, m interface{}) error {
+	if resource.Read != nil {
+		return resource.Read(d, m)
+	}
+	diags := resource.ReadContext(context.Background(), d, m)
+	for _, diagnostic := range diags {
+		if diagnostic.Severity == diag.Error {
+			return fmt.Errorf("%s %s", diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+	return nil
+}
+
+var importGeneratorNameInvalidChars = regexp.MustCompile("[^a-z0-9_]+")
+
+func (g *importGenerator) uniqueName(tfType string, obj model.PolicyResource) string {
+	base := *obj.Id
+	if obj.DisplayName != nil && *obj.DisplayName != "" {
+		base = *obj.DisplayName
+	}
+	base = strings.Trim(importGeneratorNameInvalidChars.ReplaceAllString(strings.ToLower(base), "_"), "_")
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = "_" + base
+	}
+
+	if g.names[tfType] == nil {
+		g.names[tfType] = make(map[string]bool)
+	}
+	name := base
+	for i := 2; g.names[tfType][name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	g.names[tfType][name] = true
+	return name
+}
+
+func (g *importGenerator) render() string {
+	var buf strings.Builder
+	for _, obj := range g.objects {
+		fmt.Fprintf(&buf, "import {\nto = %s\nid = %s\n}\n\n", obj.address(), importGeneratorQuote(obj.importID))
+
+		values := make(map[string]interface{})
+		for key := range obj.resource.Schema {
+			values[key] = obj.data.Get(key)
+		}
+		fmt.Fprintf(&buf, "resource %q %q {\n", obj.tfType, obj.name)
+		g.renderBody(&buf, obj.resource.Schema, values, obj.path)
+		buf.WriteString("}\n\n")
+	}
+
+	return buf.String()
+}
+
+// renderBody renders configurable attributes that differ from defaults, with nested
+// schemas rendered as blocks
+func (g *importGenerator) renderBody(buf *strings.Builder, schemaMap map[string]*schema.Schema, values map[string]interface{}, selfPath string) {
+	var keys []string
+	for key := range schemaMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		s := schemaMap[key]
+		if (!s.Optional && !s.Required) || s.Deprecated != "" {
+			continue
+		}
+		value := values[key]
+		if importGeneratorSkipValue(s, value) {
+			continue
+		}
+
+		var elems []interface{}
+		switch v := value.(type) {
+		case *schema.Set:
+			elems = v.List()
+		case []interface{}:
+			elems = v
+		}
+
+		if elemResource, ok := s.Elem.(*schema.Resource); ok {
+			for _, elem := range elems {
+				elemValues, ok := elem.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				fmt.Fprintf(buf, "%s {\n", key)
+				g.renderBody(buf, elemResource.Schema, elemValues, selfPath)
+				buf.WriteString("}\n")
+			}
+			continue
+		}
+
+		switch v := value.(type) {
+		case *schema.Set, []interface{}:
+			var items []string
+			for _, elem := range elems {
+				items = append(items, g.renderValue(elem, selfPath))
+			}
+			fmt.Fprintf(buf, "%s = [%s]\n", key, strings.Join(items, ", "))
+		case map[string]interface{}:
+			var mapKeys []string
+			for mapKey := range v {
+				mapKeys = append(mapKeys, mapKey)
+			}
+			sort.Strings(mapKeys)
+			fmt.Fprintf(buf, "%s = {\n", key)
+			for _, mapKey := range mapKeys {
+				fmt.Fprintf(buf, "%s = %s\n", importGeneratorQuote(mapKey), g.renderValue(v[mapKey], selfPath))
+			}
+			buf.WriteString("}\n")
+		default:
+			fmt.Fprintf(buf, "%s = %s\n", key, g.renderValue(v, selfPath))
+		}
+	}
+}
+
+// renderValue renders primitive value, replacing paths of discovered objects
+// with references
+func (g *importGenerator) renderValue(value interface{}, selfPath string) string {
+	switch v := value.(type) {
+	case string:
+		if address, ok := g.addresses[v]; ok && v != selfPath {
+			return address + ".path"
+		}
+		return importGeneratorQuote(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+func importGeneratorSkipValue(s *schema.Schema, value interface{}) bool {
+	if s.Default != nil && fmt.Sprintf("%v", value) == fmt.Sprintf("%v", s.Default) {
+		return true
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		// false is meaningful only when default is true
+		return !v && s.Default == nil
+	case []interface{}:
+		return len(v) == 0
+	case *schema.Set:
+		return v.Len() == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// importGeneratorQuote renders HCL string literal, escaping template sequences
+func importGeneratorQuote(str string) string {
+	quoted := strconv.Quote(str)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
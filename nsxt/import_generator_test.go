@@ -0,0 +1,153 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+func TestImportGeneratorResources(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for tfType := range policyResourceTypes {
+		resource, ok := resources[tfType]
+		if !ok {
+			t.Errorf("Resource %s is not registered in provider", tfType)
+			continue
+		}
+		if resource.Importer == nil {
+			t.Errorf("Resource %s does not support import", tfType)
+		}
+	}
+
+	generatorResources := getImportGeneratorResources(resources)
+	if len(generatorResources) != len(policyResourceTypes) {
+		t.Errorf("Expected %d import generator resources, got %d", len(policyResourceTypes), len(generatorResources))
+	}
+	for _, r := range generatorResources {
+		if r.orgScoped != (r.tfType == "nsxt_policy_project") {
+			t.Errorf("Unexpected org scope for %s", r.tfType)
+		}
+	}
+}
+
+// TestImportGeneratorCoverage makes sure every importable policy resource is either
+// discovered by import generator or explicitly excluded
+func TestImportGeneratorCoverage(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for tfType, resource := range resources {
+		if !strings.HasPrefix(tfType, "nsxt_policy_") && !strings.HasPrefix(tfType, "nsxt_vpc_") {
+			continue
+		}
+		_, mapped := policyResourceTypes[tfType]
+		if mapped && importGeneratorExcluded[tfType] {
+			t.Errorf("Resource %s is both mapped to NSX resource type and excluded from import generator", tfType)
+		}
+		if resource.Importer != nil && !mapped && !importGeneratorExcluded[tfType] {
+			t.Errorf("Importable resource %s is not mapped to NSX resource type in policyResourceTypes, nor excluded in importGeneratorExcluded", tfType)
+		}
+	}
+	for tfType := range importGeneratorExcluded {
+		if _, ok := resources[tfType]; !ok {
+			t.Errorf("Excluded resource %s is not registered in provider", tfType)
+		}
+	}
+}
+
+func TestImportGeneratorUniqueName(t *testing.T) {
+	g := importGenerator{names: make(map[string]map[string]bool)}
+	tests := []struct {
+		id          string
+		displayName string
+		expected    string
+	}{
+		{"web", "Web Servers", "web_servers"},
+		{"web2", "web-servers", "web_servers_2"},
+		{"db", "", "db"},
+		{"1st", "", "_1st"},
+		{"x", "$$$", "_"},
+	}
+
+	for _, test := range tests {
+		id := test.id
+		displayName := test.displayName
+		name := g.uniqueName("nsxt_policy_group", model.PolicyResource{Id: &id, DisplayName: &displayName})
+		if name != test.expected {
+			t.Errorf("Expected name %s for %s, got %s", test.expected, test.displayName, name)
+		}
+	}
+}
+
+func TestImportGeneratorQuote(t *testing.T) {
+	if quoted := importGeneratorQuote("a \"b\" ${c} %{d}"); quoted != `"a \"b\" $${c} %%{d}"` {
+		t.Errorf("Unexpected quoted string %s", quoted)
+	}
+}
+
+func TestGenerateImportConfig(t *testing.T) {
+	server, provider := testSimulatorProvider(t, "", nil)
+
+	objects := map[string]map[string]interface{}{
+		"/infra/domains/default/groups/web": {
+			"display_name": "Web Servers",
+			"description":  "web ${tier}",
+		},
+		"/infra/domains/default/security-policies/web-policy": {
+			"display_name": "web-policy",
+			"category":     "Application",
+			"rules": []interface{}{
+				map[string]interface{}{
+					"id":                 "allow-web",
+					"display_name":       "allow-web",
+					"action":             "ALLOW",
+					"sequence_number":    10,
+					"source_groups":      []interface{}{"ANY"},
+					"destination_groups": []interface{}{"/infra/domains/default/groups/web"},
+					"services":           []interface{}{"ANY"},
+					"scope":              []interface{}{"ANY"},
+				},
+			},
+		},
+		"/orgs/default/projects/dev": {
+			"display_name": "dev",
+		},
+		"/orgs/default/projects/dev/infra/domains/default/groups/app": {
+			"display_name": "app",
+		},
+	}
+	for path, obj := range objects {
+		if err := server.Put(path, obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := generateImportConfig(&buf, provider); err != nil {
+		t.Fatal(err)
+	}
+	config := buf.String()
+
+	expected := []string{
+		"to = nsxt_policy_group.web_servers",
+		`id = "/infra/domains/default/groups/web"`,
+		`description  = "web $${tier}"`,
+		"to = nsxt_policy_security_policy.web_policy",
+		"destination_groups = [nsxt_policy_group.web_servers.path]",
+		"to = nsxt_policy_project.dev",
+		`id = "dev"`,
+		"to = nsxt_policy_group.app",
+		`project_id = "dev"`,
+	}
+	for _, str := range expected {
+		if !strings.Contains(config, str) {
+			t.Errorf("Expected generated configuration to contain %s:\n%s", str, config)
+		}
+	}
+	if strings.Contains(config, "/infra/domains/default\"") {
+		t.Errorf("Expected system owned objects to be skipped:\n%s", config)
+	}
+}
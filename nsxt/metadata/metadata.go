@@ -125,6 +125,24 @@ func GetSchemaFromExtendedSchema(ext map[string]*ExtendedSchema) map[string]*sch
 	return result
 }
 
+// GetVersionRequirements collects IntroducedInVersion of attributes in extended schema,
+// keyed by attribute path with nested attributes separated by dot
+func GetVersionRequirements(ext map[string]*ExtendedSchema) map[string]string {
+	result := make(map[string]string)
+	for key, value := range ext {
+		if value.Metadata.IntroducedInVersion != "" {
+			result[key] = value.Metadata.IntroducedInVersion
+		}
+		if elem, ok := value.Schema.Elem.(*ExtendedResource); ok {
+			for nestedKey, version := range GetVersionRequirements(elem.Schema) {
+				result[key+"."+nestedKey] = version
+			}
+		}
+	}
+
+	return result
+}
+
 func getContextString(prefix, parent string, elemType reflect.Type) string {
 	ctx := elemType.String()
 	if len(parent) > 0 {
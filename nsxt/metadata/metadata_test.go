@@ -767,3 +767,37 @@ func TestSchemaToStructNilStruct(t *testing.T) {
 		assert.Nil(t, obj.StructField)
 	})
 }
+
+func TestGetVersionRequirements(t *testing.T) {
+	ext := map[string]*ExtendedSchema{
+		"plain": {
+			Schema:   schema.Schema{Type: schema.TypeString},
+			Metadata: Metadata{SchemaType: "string"},
+		},
+		"new_field": {
+			Schema:   schema.Schema{Type: schema.TypeString},
+			Metadata: Metadata{SchemaType: "string", IntroducedInVersion: "4.1.0"},
+		},
+		"block": {
+			Schema: schema.Schema{
+				Type: schema.TypeList,
+				Elem: &ExtendedResource{
+					Schema: map[string]*ExtendedSchema{
+						"nested": {
+							Schema:   schema.Schema{Type: schema.TypeBool},
+							Metadata: Metadata{SchemaType: "bool", IntroducedInVersion: "4.2.0"},
+						},
+					},
+				},
+			},
+			Metadata: Metadata{SchemaType: "struct", IntroducedInVersion: "4.0.0"},
+		},
+	}
+
+	expected := map[string]string{
+		"new_field":    "4.1.0",
+		"block":        "4.0.0",
+		"block.nested": "4.2.0",
+	}
+	assert.Equal(t, expected, GetVersionRequirements(ext))
+}
@@ -0,0 +1,147 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/migration"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
+)
+
+// mpPolicyPromotionResource maps deprecated MP resource to its policy counterpart
+type mpPolicyPromotionResource struct {
+	// resource type in NSX MP to policy promotion API
+	promotionType  string
+	policyResource string
+	// policy resource importer expects policy ID rather than path
+	importByID bool
+}
+
+var mpPolicyPromotionResources = map[string]mpPolicyPromotionResource{
+	"nsxt_logical_switch":            {promotionType: "LOGICAL_SWITCH", policyResource: "nsxt_policy_segment"},
+	"nsxt_vlan_logical_switch":       {promotionType: "LOGICAL_SWITCH", policyResource: "nsxt_policy_vlan_segment"},
+	"nsxt_logical_tier0_router":      {promotionType: "LOGICAL_ROUTER", policyResource: "nsxt_policy_tier0_gateway", importByID: true},
+	"nsxt_logical_tier1_router":      {promotionType: "LOGICAL_ROUTER", policyResource: "nsxt_policy_tier1_gateway"},
+	"nsxt_ns_group":                  {promotionType: "NS_GROUP", policyResource: "nsxt_policy_group"},
+	"nsxt_ip_set":                    {promotionType: "IP_SET", policyResource: "nsxt_policy_group"},
+	"nsxt_firewall_section":          {promotionType: "FIREWALL_SECTION", policyResource: "nsxt_policy_security_policy"},
+	"nsxt_l4_port_set_ns_service":    {promotionType: "NS_SERVICE", policyResource: "nsxt_policy_service"},
+	"nsxt_algorithm_type_ns_service": {promotionType: "NS_SERVICE", policyResource: "nsxt_policy_service"},
+	"nsxt_icmp_type_ns_service":      {promotionType: "NS_SERVICE", policyResource: "nsxt_policy_service"},
+	"nsxt_igmp_type_ns_service":      {promotionType: "NS_SERVICE", policyResource: "nsxt_policy_service"},
+	"nsxt_ether_type_ns_service":     {promotionType: "NS_SERVICE", policyResource: "nsxt_policy_service"},
+	"nsxt_ip_protocol_ns_service":    {promotionType: "NS_SERVICE", policyResource: "nsxt_policy_service"},
+	"nsxt_ns_service_group":          {promotionType: "NS_SERVICE_GROUP", policyResource: "nsxt_policy_service"},
+	"nsxt_ip_pool":                   {promotionType: "IP_POOL", policyResource: "nsxt_policy_ip_pool"},
+	"nsxt_ip_block":                  {promotionType: "IP_BLOCK", policyResource: "nsxt_policy_ip_block"},
+	"nsxt_lb_service":                {promotionType: "LB_SERVICE", policyResource: "nsxt_policy_lb_service", importByID: true},
+	"nsxt_lb_pool":                   {promotionType: "LB_POOL", policyResource: "nsxt_policy_lb_pool", importByID: true},
+	"nsxt_lb_http_virtual_server":    {promotionType: "LB_VIRTUAL_SERVER", policyResource: "nsxt_policy_lb_virtual_server", importByID: true},
+	"nsxt_lb_tcp_virtual_server":     {promotionType: "LB_VIRTUAL_SERVER", policyResource: "nsxt_policy_lb_virtual_server", importByID: true},
+	"nsxt_lb_udp_virtual_server":     {promotionType: "LB_VIRTUAL_SERVER", policyResource: "nsxt_policy_lb_virtual_server", importByID: true},
+}
+
+func getMPPolicyPromotionResourceTypes() []string {
+	var result []string
+	for name := range mpPolicyPromotionResources {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// listMPPolicyPromotedObjects returns promoted objects of given promotion type, keyed by MP ID
+func listMPPolicyPromotedObjects(connector client.Connector, promotionType string) (map[string]model.MigratedObject, error) {
+	client := migration.NewMigratedResourcesClient(connector)
+	result := make(map[string]model.MigratedObject)
+	var cursor *string
+	for {
+		objList, err := client.List(promotionType, cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objList.Results {
+			if obj.ResourceId != nil && obj.PolicyPath != nil {
+				result[*obj.ResourceId] = obj
+			}
+		}
+		cursor = objList.Cursor
+		if cursor == nil || *cursor == "" || len(objList.Results) == 0 {
+			break
+		}
+	}
+	return result, nil
+}
+
+// getMPPolicyPromotedPath returns policy path of promoted MP object
+func getMPPolicyPromotedPath(connector client.Connector, promotionType string, mpID string) (string, error) {
+	client := migration.NewMigratedResourcesClient(connector)
+	objList, err := client.List(promotionType, nil, nil, nil, &mpID, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	for _, obj := range objList.Results {
+		if obj.ResourceId != nil && *obj.ResourceId == mpID && obj.PolicyPath != nil {
+			return *obj.PolicyPath, nil
+		}
+	}
+	return "", fmt.Errorf("%s object %s was not promoted to policy", promotionType, mpID)
+}
+
+// parseMPPolicyPromotionImportID splits import ID in <MP resource type>:<MP ID> format
+func parseMPPolicyPromotionImportID(importID string) (string, string, bool) {
+	parts := strings.SplitN(importID, ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", "", false
+	}
+	if _, ok := mpPolicyPromotionResources[parts[0]]; !ok {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+func wrapMPPolicyPromotionImporter(importer schema.StateFunc, policyResource string) schema.StateFunc {
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		mpResource, mpID, ok := parseMPPolicyPromotionImportID(d.Id())
+		if !ok {
+			return importer(d, m)
+		}
+		promotion := mpPolicyPromotionResources[mpResource]
+		if promotion.policyResource != policyResource {
+			return nil, fmt.Errorf("%s can not be imported from %s, use %s instead", policyResource, mpResource, promotion.policyResource)
+		}
+
+		path, err := getMPPolicyPromotedPath(getPolicyConnector(m), promotion.promotionType, mpID)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("[INFO] Importing %s %s promoted from %s %s", policyResource, path, mpResource, mpID)
+		if promotion.importByID {
+			d.SetId(getPolicyIDFromPath(path))
+		} else {
+			d.SetId(path)
+		}
+		return importer(d, m)
+	}
+}
+
+// addMPPolicyPromotionImport allows to import policy resources by ID of MP object they
+// were promoted from, in <MP resource type>:<MP ID> format
+func addMPPolicyPromotionImport(resources map[string]*schema.Resource) {
+	wrapped := make(map[string]bool)
+	for _, promotion := range mpPolicyPromotionResources {
+		resource, ok := resources[promotion.policyResource]
+		if !ok || wrapped[promotion.policyResource] || resource.Importer == nil || resource.Importer.State == nil {
+			continue
+		}
+		resource.Importer.State = wrapMPPolicyPromotionImporter(resource.Importer.State, promotion.policyResource)
+		wrapped[promotion.policyResource] = true
+	}
+}
@@ -2,7 +2,6 @@ package nsxt
 
 import (
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -291,13 +290,27 @@ func getSecurityPolicyAndGatewayRuleSchema(scopeRequired bool, isIds bool, nsxID
 	}
 	if isIds {
 		ruleSchema["ids_profiles"] = getIdsProfilesSchema()
+	} else {
+		ruleSchema["insert_before"] = &schema.Schema{
+			Type:          schema.TypeString,
+			Description:   "NSX ID of existing rule this rule should be placed before",
+			Optional:      true,
+			ConflictsWith: getRuleAnchorConflicts(separated, "insert_after"),
+		}
+		ruleSchema["insert_after"] = &schema.Schema{
+			Type:          schema.TypeString,
+			Description:   "NSX ID of existing rule this rule should be placed after",
+			Optional:      true,
+			ConflictsWith: getRuleAnchorConflicts(separated, "insert_before"),
+		}
 	}
 	if separated {
 		ruleSchema["policy_path"] = getPolicyPathSchema(true, true, "Security Policy path")
 		ruleSchema["sequence_number"] = &schema.Schema{
 			Type:        schema.TypeInt,
 			Description: "Sequence number of the this rule",
-			Required:    true,
+			Optional:    true,
+			Computed:    true,
 		}
 		// Using computed context here, because context is required for consistency and
 		// if it's not provided it can be derived from policy_path.
@@ -313,6 +326,22 @@ func getSecurityPolicyAndGatewayRuleSchema(scopeRequired bool, isIds bool, nsxID
 	return ruleSchema
 }
 
+// removePolicyRuleAnchorsFromSchema is used by policies that are not updated with
+// anchor validation, and therefore can not position rules with anchors
+func removePolicyRuleAnchorsFromSchema(ruleSchema map[string]*schema.Schema) {
+	delete(ruleSchema, "insert_before")
+	delete(ruleSchema, "insert_after")
+}
+
+// getRuleAnchorConflicts returns attributes conflicting with rule anchor. Conflicts
+// can only be declared for standalone rules, rules within policy are validated on apply.
+func getRuleAnchorConflicts(separated bool, otherAnchor string) []string {
+	if !separated {
+		return nil
+	}
+	return []string{otherAnchor, "sequence_number"}
+}
+
 func getPolicyGatewayPolicySchema(isVPC bool) map[string]*schema.Schema {
 	secPolicy := getPolicySecurityPolicySchema(false, true, true, isVPC)
 	// GW Policies don't support scope
@@ -382,9 +411,17 @@ func getPolicySecurityPolicySchema(isIds, withContext, withRule, isVPC bool) map
 			Computed:    true,
 		},
 		"rule": getSecurityPolicyAndGatewayRulesSchema(false, isIds, true),
+		"rule_sequence_gap": {
+			Type:         schema.TypeInt,
+			Description:  "Gap between sequence numbers assigned to rules by the provider",
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
 	}
 
 	if isIds {
+		delete(result, "rule_sequence_gap")
 		delete(result, "category")
 		delete(result, "scope")
 		delete(result, "tcp_strict")
@@ -396,6 +433,7 @@ func getPolicySecurityPolicySchema(isIds, withContext, withRule, isVPC bool) map
 
 	if !withRule {
 		delete(result, "rule")
+		delete(result, "rule_sequence_gap")
 	}
 	if isVPC {
 		delete(result, "domain")
@@ -442,9 +480,20 @@ func getIgnoreTagsSchema() *schema.Schema {
 }
 
 func setPolicyRulesInSchema(d *schema.ResourceData, rules []model.Rule) error {
+	// Anchors are not stored on NSX, and are kept from the schema
+	ids, insertBefore, insertAfter := getPolicyRuleAnchorsFromSchema(d)
+	anchors := make(map[string][]string)
+	for i, id := range ids {
+		anchors[id] = []string{insertBefore[i], insertAfter[i]}
+	}
+
 	var rulesList []map[string]interface{}
-	for _, rule := range rules {
+	for _, rule := range orderPolicyRulesBySchema(d, rules) {
 		elem := make(map[string]interface{})
+		if anchor, ok := anchors[*rule.Id]; ok && anchor[0]+anchor[1] != "" {
+			elem["insert_before"] = anchor[0]
+			elem["insert_after"] = anchor[1]
+		}
 		elem["display_name"] = rule.DisplayName
 		elem["description"] = rule.Description
 		elem["path"] = rule.Path
@@ -493,6 +542,12 @@ func validatePolicyRuleSequence(d *schema.ResourceData) error {
 		data := rule.(map[string]interface{})
 		sequenceNumber := int64(data["sequence_number"].(int))
 		displayName := data["display_name"].(string)
+		insertBefore, _ := data["insert_before"].(string)
+		insertAfter, _ := data["insert_after"].(string)
+		if insertBefore != "" || insertAfter != "" {
+			// Rule is positioned by anchor rather than listed order
+			continue
+		}
 		if sequenceNumber > 0 && sequenceNumber <= latestNum {
 			return fmt.Errorf("when sequence_number is specified in a rule, it must be consistent with rule order. To avoid confusion, it is recommended to either specify sequence numbers in all rules, or none. Error detected with rule %s: %v <= %v", displayName, sequenceNumber, latestNum)
 		}
@@ -507,10 +562,9 @@ func validatePolicyRuleSequence(d *schema.ResourceData) error {
 	return nil
 }
 
-func getPolicyRulesFromSchema(d *schema.ResourceData) []model.Rule {
+func getPolicyRulesFromSchema(d *schema.ResourceData) ([]model.Rule, error) {
 	rules := d.Get("rule").([]interface{})
 	var ruleList []model.Rule
-	lastSequence := int64(0)
 	for _, rule := range rules {
 		data := rule.(map[string]interface{})
 		displayName := data["display_name"].(string)
@@ -540,17 +594,6 @@ func getPolicyRulesFromSchema(d *schema.ResourceData) []model.Rule {
 		}
 
 		resourceType := "Rule"
-		if sequenceNumber == 0 || sequenceNumber <= lastSequence {
-			// We overwrite sequence number in case its not specified,
-			// or out of order, which might be due to provider upgrade
-			// or bad user configuration
-			if sequenceNumber <= lastSequence {
-				log.Printf("[WARNING] Sequence_number %v for rule %s is out of order - overriding with sequence number %v", sequenceNumber, displayName, lastSequence+1)
-			}
-			sequenceNumber = lastSequence + 1
-		}
-		lastSequence = sequenceNumber
-
 		elem := model.Rule{
 			ResourceType:         &resourceType,
 			Id:                   &id,
@@ -577,7 +620,12 @@ func getPolicyRulesFromSchema(d *schema.ResourceData) []model.Rule {
 		ruleList = append(ruleList, elem)
 	}
 
-	return ruleList
+	// We overwrite sequence number in case its not specified, or out of order,
+	// which might be due to provider upgrade, bad user configuration, or rule anchors
+	if err := setPolicyRuleSequenceNumbers(d, ruleList); err != nil {
+		return nil, err
+	}
+	return ruleList, nil
 }
 
 func getDataSourceDisplayNameSchema() *schema.Schema {
@@ -0,0 +1,227 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+)
+
+// policyGatewayPrefixEntryResource defines a resource that owns a single prefix entry
+// of a list that is a sub-clause of Tier0 locale service config, such as BGP route
+// aggregation or OSPF summary address. Such entries are not separate API objects, hence
+// each entry is updated with read-modify-write, relying on revision to detect concurrent
+// modifications of other entries on the same locale service.
+type policyGatewayPrefixEntryResource[T any] struct {
+	// object name for logs and errors
+	name              string
+	prefixDescription string
+	flagAttribute     string
+	flagDescription   string
+	readEntries       func(connector client.Connector, gwID string, localeServiceID string) ([]T, *string, error)
+	updateEntries     func(connector client.Connector, gwID string, localeServiceID string, modify func([]T) ([]T, error)) error
+	getEntryPrefix    func(entry T) *string
+	getEntryFlag      func(entry T) *bool
+	newEntry          func(prefix string, flag bool) T
+}
+
+func (r *policyGatewayPrefixEntryResource[T]) resource() *schema.Resource {
+	return &schema.Resource{
+		Create: r.create,
+		Read:   r.read,
+		Update: r.update,
+		Delete: r.delete,
+		Importer: &schema.ResourceImporter{
+			State: r.importer,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"gateway_path": getPolicyPathSchema(true, true, "Policy path for Tier0 gateway"),
+			"prefix": {
+				Type:         schema.TypeString,
+				Description:  r.prefixDescription,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCidr(),
+			},
+			r.flagAttribute: {
+				Type:        schema.TypeBool,
+				Description: r.flagDescription,
+				Optional:    true,
+				Default:     true,
+			},
+			"locale_service_id": getComputedLocaleServiceIDSchema(),
+			"gateway_id":        getComputedGatewayIDSchema(),
+		},
+	}
+}
+
+func (r *policyGatewayPrefixEntryResource[T]) modifyEntries(m interface{}, gwID string, localeServiceID string, modify func([]T) ([]T, error)) error {
+	connector := getPolicyConnector(m)
+	doUpdate := func() error {
+		return r.updateEntries(connector, gwID, localeServiceID, modify)
+	}
+
+	commonProviderConfig := getCommonProviderConfig(m)
+	return retryUponPreconditionFailed(doUpdate, commonProviderConfig.MaxRetries)
+}
+
+func (r *policyGatewayPrefixEntryResource[T]) isEntryForPrefix(entry T, prefix string) bool {
+	entryPrefix := r.getEntryPrefix(entry)
+	return entryPrefix != nil && *entryPrefix == prefix
+}
+
+func (r *policyGatewayPrefixEntryResource[T]) getEntryFromSchema(d *schema.ResourceData) T {
+	return r.newEntry(d.Get("prefix").(string), d.Get(r.flagAttribute).(bool))
+}
+
+func (r *policyGatewayPrefixEntryResource[T]) getIDs(d *schema.ResourceData) (string, string, error) {
+	gwID := d.Get("gateway_id").(string)
+	localeServiceID := d.Get("locale_service_id").(string)
+	if d.Id() == "" || gwID == "" || localeServiceID == "" {
+		return "", "", fmt.Errorf("Error obtaining Tier0 Gateway id or Locale Service id")
+	}
+	return gwID, localeServiceID, nil
+}
+
+func (r *policyGatewayPrefixEntryResource[T]) create(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if !isT0 {
+		return fmt.Errorf("Tier0 Gateway path expected, got %s", gwPath)
+	}
+
+	localeService, err := getPolicyTier0GatewayLocaleServiceWithEdgeCluster(getSessionContext(d, m), gwID, connector)
+	if err != nil || localeService == nil {
+		return fmt.Errorf("Tier0 Gateway path with configured edge cluster expected, got %s", gwPath)
+	}
+	localeServiceID := *localeService.Id
+
+	prefix := d.Get("prefix").(string)
+	entry := r.getEntryFromSchema(d)
+	addEntry := func(entries []T) ([]T, error) {
+		for _, existing := range entries {
+			if r.isEntryForPrefix(existing, prefix) {
+				return nil, fmt.Errorf("%s %s already exists on gateway %s", r.name, prefix, gwID)
+			}
+		}
+		return append(entries, entry), nil
+	}
+
+	id := newUUID()
+	err = r.modifyEntries(m, gwID, localeServiceID, addEntry)
+	if err != nil {
+		return handleCreateError(r.name, prefix, err)
+	}
+
+	d.SetId(id)
+	d.Set("gateway_id", gwID)
+	d.Set("locale_service_id", localeServiceID)
+
+	return r.read(d, m)
+}
+
+func (r *policyGatewayPrefixEntryResource[T]) read(d *schema.ResourceData, m interface{}) error {
+	gwID, localeServiceID, err := r.getIDs(d)
+	if err != nil {
+		return err
+	}
+
+	prefix := d.Get("prefix").(string)
+	entries, localeServicePath, err := r.readEntries(getPolicyConnector(m), gwID, localeServiceID)
+	if err != nil {
+		return handleReadError(d, r.name, prefix, err)
+	}
+
+	for _, entry := range entries {
+		if r.isEntryForPrefix(entry, prefix) {
+			d.Set(r.flagAttribute, r.getEntryFlag(entry))
+			if localeServicePath != nil {
+				d.Set("gateway_path", getGatewayPathFromLocaleServicesPath(*localeServicePath))
+			}
+			return nil
+		}
+	}
+
+	log.Printf("[INFO] %s %s not found on gateway %s", r.name, prefix, gwID)
+	d.SetId("")
+	return nil
+}
+
+func (r *policyGatewayPrefixEntryResource[T]) update(d *schema.ResourceData, m interface{}) error {
+	gwID, localeServiceID, err := r.getIDs(d)
+	if err != nil {
+		return err
+	}
+
+	prefix := d.Get("prefix").(string)
+	entry := r.getEntryFromSchema(d)
+	replaceEntry := func(entries []T) ([]T, error) {
+		for i, existing := range entries {
+			if r.isEntryForPrefix(existing, prefix) {
+				entries[i] = entry
+				return entries, nil
+			}
+		}
+		return append(entries, entry), nil
+	}
+
+	err = r.modifyEntries(m, gwID, localeServiceID, replaceEntry)
+	if err != nil {
+		return handleUpdateError(r.name, prefix, err)
+	}
+
+	return r.read(d, m)
+}
+
+func (r *policyGatewayPrefixEntryResource[T]) delete(d *schema.ResourceData, m interface{}) error {
+	gwID, localeServiceID, err := r.getIDs(d)
+	if err != nil {
+		return err
+	}
+
+	prefix := d.Get("prefix").(string)
+	removeEntry := func(entries []T) ([]T, error) {
+		var result []T
+		for _, existing := range entries {
+			if r.isEntryForPrefix(existing, prefix) {
+				continue
+			}
+			result = append(result, existing)
+		}
+		return result, nil
+	}
+
+	err = r.modifyEntries(m, gwID, localeServiceID, removeEntry)
+	if err != nil {
+		return handleDeleteError(r.name, prefix, err)
+	}
+
+	return nil
+}
+
+func (r *policyGatewayPrefixEntryResource[T]) importer(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	// prefix contains a slash as well
+	s := strings.SplitN(importID, "/", 3)
+	if len(s) != 3 {
+		return nil, fmt.Errorf("Please provide <tier0-gateway-id>/<locale-service-id>/<prefix> as an input")
+	}
+
+	d.Set("gateway_id", s[0])
+	d.Set("locale_service_id", s[1])
+	d.Set("prefix", s[2])
+	d.SetId(newUUID())
+
+	return []*schema.ResourceData{d}, nil
+}
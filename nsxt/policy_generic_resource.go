@@ -0,0 +1,276 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/metadata"
+)
+
+// policyResourceClient is the set of operations generic policy resources need
+// from generated api clients
+type policyResourceClient[T any] interface {
+	Get(id string) (T, error)
+	Patch(id string, obj T) error
+	Delete(id string) error
+}
+
+type policyOverridableClient[T any] interface {
+	Get(id string) (T, error)
+	Patch(id string, obj T, override *bool) error
+	Delete(id string, override *bool) error
+}
+
+// policyOverrideResourceClient adapts generated clients that accept override
+// flag on modification (such as segment profiles) to policyResourceClient
+type policyOverrideResourceClient[T any] struct {
+	client policyOverridableClient[T]
+}
+
+func (c policyOverrideResourceClient[T]) Get(id string) (T, error) {
+	return c.client.Get(id)
+}
+
+func (c policyOverrideResourceClient[T]) Patch(id string, obj T) error {
+	boolFalse := false
+	return c.client.Patch(id, obj, &boolFalse)
+}
+
+func (c policyOverrideResourceClient[T]) Delete(id string) error {
+	boolFalse := false
+	return c.client.Delete(id, &boolFalse)
+}
+
+// policyGenericResource defines policy resource and data source fully driven by
+// metadata. Standard attributes (display_name, description, tag, revision, path
+// and nsx_id) are handled here, the rest is converted by metadata package.
+type policyGenericResource[T any] struct {
+	// object name for logs and errors
+	name string
+	// NSX resource type, used in data source search
+	resourceType string
+	// policy path of the object on local manager, with {id} placeholder
+	pathTemplate string
+	// minimal NSX version supporting the object, validated at plan time
+	introducedInVersion string
+	schema              *metadata.ExtendedResource
+	// client factory, expected to return nil if session context is not supported
+	newClient func(sessionContext utl.SessionContext, connector client.Connector) policyResourceClient[T]
+}
+
+func (r *policyGenericResource[T]) resource() *schema.Resource {
+	return &schema.Resource{
+		Create: r.create,
+		Read:   r.read,
+		Update: r.update,
+		Delete: r.delete,
+		Importer: &schema.ResourceImporter{
+			State: r.importer,
+		},
+		CustomizeDiff: getVersionValidationCustomizeDiff(r.name, nsxtVersionRequirement{
+			version:    r.introducedInVersion,
+			attributes: metadata.GetVersionRequirements(r.schema.Schema),
+		}),
+
+		Schema: metadata.GetSchemaFromExtendedSchema(r.schema.Schema),
+	}
+}
+
+func (r *policyGenericResource[T]) dataSource() *schema.Resource {
+	dataSchema := map[string]*schema.Schema{
+		"id":           getDataSourceIDSchema(),
+		"display_name": getDataSourceDisplayNameSchema(),
+		"description":  getDataSourceDescriptionSchema(),
+		"path":         getPathSchema(),
+	}
+	if context, ok := r.schema.Schema["context"]; ok {
+		contextSchema := context.Schema
+		dataSchema["context"] = &contextSchema
+	}
+
+	return &schema.Resource{
+		Read:   r.dataSourceRead,
+		Schema: dataSchema,
+	}
+}
+
+func (r *policyGenericResource[T]) exists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	client := r.newClient(sessionContext, connector)
+	if client == nil {
+		return false, policyResourceNotSupportedError()
+	}
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError(fmt.Sprintf("Error retrieving %s", r.name), err)
+}
+
+// setStandardFields sets attributes common to all policy objects from schema
+func (r *policyGenericResource[T]) setStandardFields(elem reflect.Value, d *schema.ResourceData, withRevision bool) {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	elem.FieldByName("DisplayName").Set(reflect.ValueOf(&displayName))
+	elem.FieldByName("Description").Set(reflect.ValueOf(&description))
+	elem.FieldByName("Tags").Set(reflect.ValueOf(getPolicyTagsFromSchema(d)))
+	if field := elem.FieldByName("ResourceType"); field.IsValid() {
+		resourceType := r.resourceType
+		field.Set(reflect.ValueOf(&resourceType))
+	}
+	if withRevision {
+		revision := int64(d.Get("revision").(int))
+		elem.FieldByName("Revision").Set(reflect.ValueOf(&revision))
+	}
+}
+
+func (r *policyGenericResource[T]) patch(d *schema.ResourceData, m interface{}, id string, withRevision bool) error {
+	var obj T
+	elem := reflect.ValueOf(&obj).Elem()
+	r.setStandardFields(elem, d, withRevision)
+	if err := metadata.SchemaToStruct(elem, d, r.schema.Schema, "", nil); err != nil {
+		return err
+	}
+
+	client := r.newClient(getSessionContext(d, m), getPolicyConnector(m))
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	return client.Patch(id, obj)
+}
+
+func (r *policyGenericResource[T]) create(d *schema.ResourceData, m interface{}) error {
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, r.exists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating %s with ID %s", r.name, id)
+	err = r.patch(d, m, id, false)
+	if err != nil {
+		return handleCreateError(r.name, id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return r.read(d, m)
+}
+
+func (r *policyGenericResource[T]) read(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining %s ID", r.name)
+	}
+
+	client := r.newClient(getSessionContext(d, m), getPolicyConnector(m))
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, r.name, id, err)
+	}
+
+	elem := reflect.ValueOf(&obj).Elem()
+	setPolicyTagsInSchema(d, elem.FieldByName("Tags").Interface().([]model.Tag))
+	d.Set("nsx_id", id)
+	d.Set("display_name", elem.FieldByName("DisplayName").Interface())
+	d.Set("description", elem.FieldByName("Description").Interface())
+	d.Set("revision", elem.FieldByName("Revision").Interface())
+	d.Set("path", elem.FieldByName("Path").Interface())
+
+	return metadata.StructToSchema(elem, d, r.schema.Schema, "", nil)
+}
+
+func (r *policyGenericResource[T]) update(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining %s ID", r.name)
+	}
+
+	log.Printf("[INFO] Updating %s with ID %s", r.name, id)
+	err := r.patch(d, m, id, true)
+	if err != nil {
+		return handleUpdateError(r.name, id, err)
+	}
+
+	return r.read(d, m)
+}
+
+func (r *policyGenericResource[T]) delete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining %s ID", r.name)
+	}
+
+	client := r.newClient(getSessionContext(d, m), getPolicyConnector(m))
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError(r.name, id, err)
+	}
+
+	return nil
+}
+
+func (r *policyGenericResource[T]) importer(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	if isPolicyPath(importID) && !policyPathMatchesTemplate(importID, r.pathTemplate) {
+		return nil, fmt.Errorf("%s is not a valid %s path", importID, r.name)
+	}
+
+	return nsxtPolicyPathResourceImporter(d, m)
+}
+
+func (r *policyGenericResource[T]) dataSourceRead(d *schema.ResourceData, m interface{}) error {
+	_, err := policyDataSourceResourceRead(d, getPolicyConnector(m), getSessionContext(d, m), r.resourceType, nil)
+	return err
+}
+
+// policyPathMatchesTemplate checks whether policy path corresponds to a local
+// manager path template, allowing for global manager and multitenancy prefixes.
+// Template segments in curly braces match any value.
+func policyPathMatchesTemplate(policyPath string, template string) bool {
+	pathSegs := strings.Split(policyPath, "/")
+	if len(pathSegs) > 4 && pathSegs[1] == "orgs" && pathSegs[3] == "projects" {
+		pathSegs = append([]string{""}, pathSegs[5:]...)
+	} else if len(pathSegs) > 1 && pathSegs[1] == "global-infra" {
+		pathSegs[1] = "infra"
+	}
+
+	templateSegs := strings.Split(template, "/")
+	if len(pathSegs) != len(templateSegs) {
+		return false
+	}
+	for i, seg := range templateSegs {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			if pathSegs[i] == "" {
+				return false
+			}
+			continue
+		}
+		if seg != pathSegs[i] {
+			return false
+		}
+	}
+
+	return true
+}
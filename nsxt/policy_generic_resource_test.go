@@ -0,0 +1,190 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/metadata"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
+)
+
+func policyGenericResourceTestValue(item *metadata.ExtendedSchema, create bool) string {
+	value := item.Metadata.TestData.CreateValue
+	if !create {
+		value = item.Metadata.TestData.UpdateValue
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+func policyGenericResourceTestSkip(item *metadata.ExtendedSchema) bool {
+	if item.Metadata.Skip || item.Metadata.TestData.CreateValue == nil {
+		return true
+	}
+	return item.Metadata.IntroducedInVersion != "" && util.NsxVersionLower(item.Metadata.IntroducedInVersion)
+}
+
+// getPolicyGenericResourceTestConfigAttributes renders HCL attributes from metadata test data
+func getPolicyGenericResourceTestConfigAttributes(extSchema map[string]*metadata.ExtendedSchema, create bool) string {
+	result := ""
+	for key, item := range extSchema {
+		if policyGenericResourceTestSkip(item) {
+			continue
+		}
+
+		value := policyGenericResourceTestValue(item, create)
+		if item.Schema.Type == schema.TypeString {
+			result += fmt.Sprintf("\n  %s = \"%s\"", key, value)
+		} else {
+			result += fmt.Sprintf("\n  %s = %s", key, value)
+		}
+	}
+
+	return result
+}
+
+// getPolicyGenericResourceTestCheckFuncs verifies attributes against metadata test data
+func getPolicyGenericResourceTestCheckFuncs(extSchema map[string]*metadata.ExtendedSchema, testResourceName string, create bool) []resource.TestCheckFunc {
+	var result []resource.TestCheckFunc
+	for key, item := range extSchema {
+		if policyGenericResourceTestSkip(item) {
+			continue
+		}
+
+		result = append(result, resource.TestCheckResourceAttr(testResourceName, key, policyGenericResourceTestValue(item, create)))
+	}
+
+	return result
+}
+
+func testAccNsxtPolicyGenericResourceExists[T any](r *policyGenericResource[T], resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy %s resource %s not found in resources", r.name, resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy %s resource ID not set in resources", r.name)
+		}
+
+		exists, err := r.exists(testAccGetSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy %s %s does not exist", r.name, resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyGenericResourceCheckDestroy[T any](r *policyGenericResource[T], resourceType string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+		for _, rs := range state.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			resourceID := rs.Primary.Attributes["id"]
+			exists, err := r.exists(testAccGetSessionContext(), resourceID, connector)
+			if err != nil {
+				return err
+			}
+
+			if exists {
+				return fmt.Errorf("Policy %s %s still exists", r.name, resourceID)
+			}
+		}
+		return nil
+	}
+}
+
+func TestPolicyPathMatchesTemplate(t *testing.T) {
+	template := "/infra/mac-discovery-profiles/{profile-id}"
+	tests := []struct {
+		path     string
+		expected bool
+	}{
+		{"/infra/mac-discovery-profiles/p1", true},
+		{"/global-infra/mac-discovery-profiles/p1", true},
+		{"/orgs/default/projects/dev/infra/mac-discovery-profiles/p1", true},
+		{"/infra/ip-discovery-profiles/p1", false},
+		{"/infra/mac-discovery-profiles/p1/extra", false},
+		{"/infra/mac-discovery-profiles/", false},
+	}
+
+	for _, test := range tests {
+		if policyPathMatchesTemplate(test.path, template) != test.expected {
+			t.Errorf("Expected match result %v for path %s", test.expected, test.path)
+		}
+	}
+}
+
+func TestPolicyGenericResourceLifecycle(t *testing.T) {
+	server, provider := testSimulatorProvider(t, "", nil)
+	m := provider.Meta()
+
+	r := policyMacDiscoveryProfileResource
+	res := r.resource()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"display_name": "test",
+		"mac_limit":    20,
+		"tag": []interface{}{
+			map[string]interface{}{"scope": "scope1", "tag": "tag1"},
+		},
+	})
+	if err := res.Create(d, m); err != nil {
+		t.Fatal(err)
+	}
+	path := d.Get("path").(string)
+	if path != "/infra/mac-discovery-profiles/"+d.Id() || d.Get("mac_limit").(int) != 20 || d.Get("tag.#").(int) != 1 {
+		t.Errorf("Unexpected state after create: path %s, mac_limit %v, tags %v", path, d.Get("mac_limit"), d.Get("tag.#"))
+	}
+
+	d.Set("mac_limit", 50)
+	if err := res.Update(d, m); err != nil {
+		t.Fatal(err)
+	}
+	obj, ok := server.Get(path)
+	if !ok || obj["mac_limit"] != float64(50) || d.Get("revision").(int) != 1 {
+		t.Errorf("Unexpected object after update: %v, revision %v", obj, d.Get("revision"))
+	}
+
+	ds := r.dataSource()
+	dd := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{"display_name": "test"})
+	if err := ds.Read(dd, m); err != nil {
+		t.Fatal(err)
+	}
+	if dd.Get("path").(string) != path {
+		t.Errorf("Expected data source path %s, got %s", path, dd.Get("path"))
+	}
+
+	di := res.TestResourceData()
+	di.SetId(path)
+	if _, err := res.Importer.State(di, m); err != nil || di.Id() != d.Id() {
+		t.Errorf("Failed to import %s: %v", path, err)
+	}
+	di.SetId("/infra/ip-discovery-profiles/" + d.Id())
+	if _, err := res.Importer.State(di, m); err == nil {
+		t.Errorf("Expected import of mismatching path to fail")
+	}
+
+	if err := res.Delete(d, m); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := server.Get(path); ok {
+		t.Errorf("Expected %s to be deleted", path)
+	}
+}
@@ -0,0 +1,221 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Gap between sequence numbers of standalone rules, leaving room for rules inserted later
+const standalonePolicyRuleSequenceGap = int64(10)
+
+// assignPolicyRuleSequenceNumbers returns sequence numbers for rules in given order.
+// Existing numbers that are consistent with the order are kept, and missing or out of
+// order numbers are allocated with given gap, or within the room left between
+// neighbouring rules. Following rules are renumbered only if there is not enough room.
+func assignPolicyRuleSequenceNumbers(sequences []int64, gap int64) []int64 {
+	result := make([]int64, len(sequences))
+	copy(result, sequences)
+	last := int64(0)
+	i := 0
+	for i < len(result) {
+		if result[i] > last {
+			last = result[i]
+			i++
+			continue
+		}
+
+		// Find the next rule with valid sequence number that leaves enough room for the rules
+		// in between, otherwise add it to the rules that need renumbering
+		j := i + 1
+		for j < len(result) && (result[j] <= last || result[j]-last <= int64(j-i)) {
+			j++
+		}
+
+		step := gap
+		if j < len(result) {
+			if room := (result[j] - last) / int64(j-i+1); room < step {
+				step = room
+			}
+		}
+		for ; i < j; i++ {
+			last += step
+			result[i] = last
+		}
+	}
+	return result
+}
+
+// getPolicyRuleOrder returns indexes of rules in the order they should be placed on NSX.
+// Rules keep the order they are listed in, except for rules with anchor, which are placed
+// next to the anchor rule.
+func getPolicyRuleOrder(ids []string, insertBefore []string, insertAfter []string) ([]int, error) {
+	var order []int
+	var pending []int
+	for i := range ids {
+		if insertBefore[i] != "" && insertAfter[i] != "" {
+			return nil, fmt.Errorf("only one of insert_before and insert_after can be specified in rule %d", i)
+		}
+		if insertBefore[i] == "" && insertAfter[i] == "" {
+			order = append(order, i)
+		} else {
+			pending = append(pending, i)
+		}
+	}
+
+	for len(pending) > 0 {
+		var unresolved []int
+		for _, i := range pending {
+			anchor := insertBefore[i] + insertAfter[i]
+			position := -1
+			for pos, j := range order {
+				if ids[j] == anchor {
+					position = pos
+					break
+				}
+			}
+			if position < 0 {
+				unresolved = append(unresolved, i)
+				continue
+			}
+			if insertAfter[i] != "" {
+				// Keep listed order of rules placed after the same anchor
+				position++
+				for position < len(order) && insertAfter[order[position]] == anchor {
+					position++
+				}
+			}
+			order = append(order[:position], append([]int{i}, order[position:]...)...)
+		}
+		if len(unresolved) == len(pending) {
+			i := unresolved[0]
+			return nil, fmt.Errorf("rule %d can not be placed next to rule %s, which is not found in the policy or refers back to this rule", i, insertBefore[i]+insertAfter[i])
+		}
+		pending = unresolved
+	}
+	return order, nil
+}
+
+func getPolicyRuleAnchorsFromSchema(d *schema.ResourceData) ([]string, []string, []string) {
+	var ids, insertBefore, insertAfter []string
+	for _, rule := range d.Get("rule").([]interface{}) {
+		data := rule.(map[string]interface{})
+		id, _ := data["nsx_id"].(string)
+		before, _ := data["insert_before"].(string)
+		after, _ := data["insert_after"].(string)
+		ids = append(ids, id)
+		insertBefore = append(insertBefore, before)
+		insertAfter = append(insertAfter, after)
+	}
+	return ids, insertBefore, insertAfter
+}
+
+func isPolicyRuleAnchorUsed(d *schema.ResourceData) bool {
+	_, insertBefore, insertAfter := getPolicyRuleAnchorsFromSchema(d)
+	for i := range insertBefore {
+		if insertBefore[i] != "" || insertAfter[i] != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func getPolicyRuleSequenceGap(d *schema.ResourceData) int64 {
+	if gap, ok := d.GetOk("rule_sequence_gap"); ok {
+		return int64(gap.(int))
+	}
+	return 1
+}
+
+// setPolicyRuleSequenceNumbers assigns sequence numbers to rules listed in schema order,
+// according to rule anchors and sequence number gap
+func setPolicyRuleSequenceNumbers(d *schema.ResourceData, rules []model.Rule) error {
+	ids := make([]string, len(rules))
+	for i, rule := range rules {
+		ids[i] = *rule.Id
+	}
+	_, insertBefore, insertAfter := getPolicyRuleAnchorsFromSchema(d)
+	order, err := getPolicyRuleOrder(ids, insertBefore, insertAfter)
+	if err != nil {
+		return err
+	}
+
+	sequences := make([]int64, len(order))
+	for pos, i := range order {
+		sequences[pos] = *rules[i].SequenceNumber
+	}
+	sequences = assignPolicyRuleSequenceNumbers(sequences, getPolicyRuleSequenceGap(d))
+	for pos, i := range order {
+		if sequences[pos] != *rules[i].SequenceNumber {
+			log.Printf("[DEBUG] Assigning sequence number %v to rule %s", sequences[pos], *rules[i].DisplayName)
+		}
+		sequence := sequences[pos]
+		rules[i].SequenceNumber = &sequence
+	}
+	return nil
+}
+
+// setPolicyRuleIDsInSchema stores IDs generated for new rules, so that rules can be
+// matched with their anchors on read
+func setPolicyRuleIDsInSchema(d *schema.ResourceData, rules []model.Rule) error {
+	ruleList := d.Get("rule").([]interface{})
+	for i, rule := range ruleList {
+		rule.(map[string]interface{})["nsx_id"] = *rules[i].Id
+	}
+	return d.Set("rule", ruleList)
+}
+
+// orderPolicyRulesBySchema orders rules read from NSX same as rules in schema, and
+// places rules that are not in schema at the end, in NSX order. This is needed when
+// rules are positioned with anchors, and therefore the NSX order differs from schema.
+// Rules without ID in schema, such as rules that failed to be created, are matched with
+// remaining rules by display name.
+func orderPolicyRulesBySchema(d *schema.ResourceData, rules []model.Rule) []model.Rule {
+	if !isPolicyRuleAnchorUsed(d) {
+		return rules
+	}
+	ids, _, _ := getPolicyRuleAnchorsFromSchema(d)
+	used := make([]bool, len(rules))
+	matched := make([]int, len(ids))
+	for pos, id := range ids {
+		matched[pos] = -1
+		for i, rule := range rules {
+			if id != "" && !used[i] && rule.Id != nil && *rule.Id == id {
+				matched[pos] = i
+				used[i] = true
+				break
+			}
+		}
+	}
+	for pos, id := range ids {
+		if id != "" {
+			continue
+		}
+		displayName := d.Get(fmt.Sprintf("rule.%d.display_name", pos)).(string)
+		for i, rule := range rules {
+			if !used[i] && rule.DisplayName != nil && *rule.DisplayName == displayName {
+				matched[pos] = i
+				used[i] = true
+				break
+			}
+		}
+	}
+
+	var result []model.Rule
+	for _, i := range matched {
+		if i >= 0 {
+			result = append(result, rules[i])
+		}
+	}
+	for i, rule := range rules {
+		if !used[i] {
+			result = append(result, rule)
+		}
+	}
+	return result
+}
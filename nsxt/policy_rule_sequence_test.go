@@ -0,0 +1,274 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestAssignPolicyRuleSequenceNumbers(t *testing.T) {
+	cases := []struct {
+		sequences []int64
+		gap       int64
+		expected  []int64
+	}{
+		{[]int64{0, 0, 0}, 1, []int64{1, 2, 3}},
+		{[]int64{0, 0, 0}, 10, []int64{10, 20, 30}},
+		{[]int64{1, 0, 2, 3}, 1, []int64{1, 2, 3, 4}},
+		{[]int64{10, 0, 20, 30}, 10, []int64{10, 15, 20, 30}},
+		{[]int64{10, 0, 0, 20}, 10, []int64{10, 13, 16, 20}},
+		{[]int64{10, 0, 11, 30}, 10, []int64{10, 16, 22, 30}},
+		{[]int64{10, 20, 30, 0}, 10, []int64{10, 20, 30, 40}},
+		{[]int64{5, 3, 8}, 1, []int64{5, 6, 8}},
+		{[]int64{0, 5}, 10, []int64{2, 5}},
+		{[]int64{0, 1}, 10, []int64{10, 20}},
+	}
+	for _, c := range cases {
+		result := assignPolicyRuleSequenceNumbers(c.sequences, c.gap)
+		if !reflect.DeepEqual(result, c.expected) {
+			t.Errorf("Sequence numbers %v with gap %v: expected %v, got %v", c.sequences, c.gap, c.expected, result)
+		}
+	}
+}
+
+func TestGetPolicyRuleOrder(t *testing.T) {
+	cases := []struct {
+		ids          []string
+		insertBefore []string
+		insertAfter  []string
+		expected     []int
+		fails        bool
+	}{
+		{[]string{"a", "b", "c"}, []string{"", "", ""}, []string{"", "", ""}, []int{0, 1, 2}, false},
+		{[]string{"a", "b", "c", "d"}, []string{"", "", "", ""}, []string{"", "", "", "a"}, []int{0, 3, 1, 2}, false},
+		{[]string{"a", "b", "c", "d"}, []string{"", "", "", "a"}, []string{"", "", "", ""}, []int{3, 0, 1, 2}, false},
+		{[]string{"a", "b", "c", "d"}, []string{"", "", "", ""}, []string{"", "a", "", "a"}, []int{0, 1, 3, 2}, false},
+		{[]string{"a", "b", "c", "d"}, []string{"", "", "", ""}, []string{"", "", "d", "a"}, []int{0, 3, 2, 1}, false},
+		{[]string{"a", "b"}, []string{"", ""}, []string{"", "x"}, nil, true},
+		{[]string{"a", "b"}, []string{"", "a"}, []string{"", "a"}, nil, true},
+		{[]string{"a", "b"}, []string{"b", ""}, []string{"", "a"}, nil, true},
+	}
+	for _, c := range cases {
+		result, err := getPolicyRuleOrder(c.ids, c.insertBefore, c.insertAfter)
+		if c.fails {
+			if err == nil {
+				t.Errorf("Expected ordering of %v to fail", c)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Ordering of %v failed: %v", c, err)
+		} else if !reflect.DeepEqual(result, c.expected) {
+			t.Errorf("Ordering of %v: expected %v, got %v", c, c.expected, result)
+		}
+	}
+}
+
+func getSimulatorRuleSequences(server *simulator.Server, policyPath string, ids []string) []int64 {
+	var result []int64
+	for _, id := range ids {
+		obj, _ := server.Get(policyPath + "/rules/" + id)
+		result = append(result, getSimulatorInt64(obj["sequence_number"]))
+	}
+	return result
+}
+
+func getSimulatorInt64(value interface{}) int64 {
+	switch v := value.(type) {
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	case float64:
+		return int64(v)
+	}
+	return 0
+}
+
+func TestPolicyRuleAnchors(t *testing.T) {
+	server, provider := testSimulatorProvider(t, "", nil)
+	m := provider.Meta()
+
+	res := provider.ResourcesMap["nsxt_policy_security_policy"]
+	rule := func(id string, after string) map[string]interface{} {
+		return map[string]interface{}{"nsx_id": id, "display_name": id, "action": "ALLOW", "insert_after": after}
+	}
+	config := map[string]interface{}{
+		"nsx_id":            "policy1",
+		"display_name":      "policy1",
+		"category":          "Application",
+		"rule_sequence_gap": 10,
+		"rule":              []interface{}{rule("r1", ""), rule("r2", ""), rule("r3", "")},
+	}
+	d := schema.TestResourceDataRaw(t, res.Schema, config)
+	if err := res.Create(d, m); err != nil {
+		t.Fatal(err)
+	}
+	policyPath := "/infra/domains/default/security-policies/policy1"
+	if sequences := getSimulatorRuleSequences(server, policyPath, []string{"r1", "r2", "r3"}); !reflect.DeepEqual(sequences, []int64{10, 20, 30}) {
+		t.Errorf("Unexpected sequence numbers after create: %v", sequences)
+	}
+
+	// Rules appended to the list are placed next to their anchors, without renumbering
+	config["rule"] = []interface{}{rule("r1", ""), rule("r2", ""), rule("r3", ""), rule("r4", "r1"), rule("", "r4")}
+	state := d.State()
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err = schema.InternalMap(res.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := res.Update(d, m); err != nil {
+		t.Fatal(err)
+	}
+	if sequences := getSimulatorRuleSequences(server, policyPath, []string{"r1", "r2", "r3", "r4"}); !reflect.DeepEqual(sequences, []int64{10, 20, 30, 13}) {
+		t.Errorf("Unexpected sequence numbers after insert: %v", sequences)
+	}
+	var names []string
+	for i := 0; i < d.Get("rule.#").(int); i++ {
+		names = append(names, d.Get(fmt.Sprintf("rule.%d.display_name", i)).(string))
+	}
+	if len(names) != 5 || names[3] != "r4" || d.Get("rule.3.insert_after").(string) != "r1" || d.Get("rule.4.insert_after").(string) != "r4" || d.Get("rule.4.nsx_id").(string) == "" {
+		t.Errorf("Expected rules to keep configured order and anchors, got %v: %v", names, d.Get("rule"))
+	}
+	if d.Get("rule.4.sequence_number").(int) != 16 {
+		t.Errorf("Expected rule anchored to new rule to be placed after it, got %v", d.Get("rule.4.sequence_number"))
+	}
+
+	config["rule"] = []interface{}{rule("r1", ""), rule("r2", "x")}
+	state = d.State()
+	diff, err = res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err = schema.InternalMap(res.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := res.Update(d, m); err == nil {
+		t.Errorf("Expected update with unknown anchor to fail")
+	}
+}
+
+func TestPolicySecurityPolicyRuleAnchors(t *testing.T) {
+	server, provider := testSimulatorProvider(t, "", nil)
+
+	policyPath := "/infra/domains/default/security-policies/parent"
+	if err := server.Put(policyPath, map[string]interface{}{"resource_type": "SecurityPolicy", "category": "Application"}); err != nil {
+		t.Fatal(err)
+	}
+	for id, sequence := range map[string]int{"r1": 1, "r2": 2, "r3": 12} {
+		if err := server.Put(policyPath+"/rules/"+id, map[string]interface{}{"resource_type": "Rule", "action": "ALLOW", "sequence_number": sequence}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m := provider.Meta()
+
+	res := provider.ResourcesMap["nsxt_policy_security_policy_rule"]
+	config := map[string]interface{}{
+		"nsx_id":        "new",
+		"display_name":  "new",
+		"policy_path":   policyPath,
+		"action":        "DROP",
+		"insert_before": "r2",
+	}
+	d := schema.TestResourceDataRaw(t, res.Schema, config)
+	// No room between r1 and r2, and other rules are not renumbered
+	if err := res.Create(d, m); err == nil {
+		t.Errorf("Expected rule placement without room to fail")
+	}
+
+	config["insert_before"] = "r3"
+	d = schema.TestResourceDataRaw(t, res.Schema, config)
+	if err := res.Create(d, m); err != nil {
+		t.Fatal(err)
+	}
+	if sequences := getSimulatorRuleSequences(server, policyPath, []string{"r1", "r2", "new", "r3"}); !reflect.DeepEqual(sequences, []int64{1, 2, 7, 12}) {
+		t.Errorf("Unexpected sequence numbers after create: %v", sequences)
+	}
+	if d.Get("sequence_number").(int) != 7 || d.Get("insert_before").(string) != "r3" {
+		t.Errorf("Unexpected rule state: sequence %v, insert_before %v", d.Get("sequence_number"), d.Get("insert_before"))
+	}
+
+	// Rule moved outside of terraform is detected
+	obj, _ := server.Get(policyPath + "/rules/new")
+	obj["sequence_number"] = 40
+	if err := server.Put(policyPath+"/rules/new", obj); err != nil {
+		t.Fatal(err)
+	}
+	if err := res.Read(d, m); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("insert_before").(string) != "" {
+		t.Errorf("Expected misplaced rule to clear its anchor")
+	}
+
+	d = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"nsx_id":       "last",
+		"display_name": "last",
+		"policy_path":  policyPath,
+		"action":       "DROP",
+	})
+	if err := res.Create(d, m); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("sequence_number").(int) != 50 {
+		t.Errorf("Expected rule without position to be appended, got sequence %v", d.Get("sequence_number"))
+	}
+}
+
+func TestOrderPolicyRulesBySchema(t *testing.T) {
+	res := resourceNsxtPolicySecurityPolicy()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"display_name": "policy1",
+		"category":     "Application",
+		"rule": []interface{}{
+			map[string]interface{}{"nsx_id": "r1", "display_name": "r1"},
+			map[string]interface{}{"display_name": "new", "insert_after": "r1"},
+		},
+	})
+
+	var rules []model.Rule
+	for _, name := range []string{"unmanaged", "new", "r1"} {
+		id := name + "-id"
+		if name == "r1" {
+			id = name
+		}
+		displayName := name
+		rules = append(rules, model.Rule{Id: &id, DisplayName: &displayName})
+	}
+
+	var names []string
+	for _, rule := range orderPolicyRulesBySchema(d, rules) {
+		names = append(names, *rule.DisplayName)
+	}
+	if !reflect.DeepEqual(names, []string{"r1", "new", "unmanaged"}) {
+		t.Errorf("Expected rule without ID to be matched by name, got %v", names)
+	}
+}
+
+func TestValidatePolicyRuleSequenceWithoutAnchors(t *testing.T) {
+	res := resourceNsxtPolicyRedirectionPolicy()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"display_name": "policy1",
+		"rule": []interface{}{
+			map[string]interface{}{"display_name": "r1", "sequence_number": 5},
+			map[string]interface{}{"display_name": "r2", "sequence_number": 3},
+		},
+	})
+	if err := validatePolicyRuleSequence(d); err == nil {
+		t.Errorf("Expected inconsistent sequence numbers to fail validation")
+	}
+}
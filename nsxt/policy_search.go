@@ -14,11 +14,69 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/search"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	lm_search "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/search"
 
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
 )
 
+// policyResourceTypes maps terraform resources to NSX resource type of the policy
+// object they manage, as used in search queries. Importable policy resources that
+// are not listed here need to be excluded from import generator explicitly, see
+// importGeneratorExcluded.
+var policyResourceTypes = map[string]string{
+	"nsxt_policy_context_profile":                      "PolicyContextProfile",
+	"nsxt_policy_dhcp_relay":                           "DhcpRelayConfig",
+	"nsxt_policy_dhcp_server":                          "DhcpServerConfig",
+	"nsxt_policy_distributed_flood_protection_profile": "DistributedFloodProtectionProfile",
+	"nsxt_policy_dns_forwarder_zone":                   "PolicyDnsForwarderZone",
+	"nsxt_policy_domain":                               "Domain",
+	"nsxt_policy_gateway_flood_protection_profile":     "GatewayFloodProtectionProfile",
+	"nsxt_policy_gateway_policy":                       "GatewayPolicy",
+	"nsxt_policy_gateway_qos_profile":                  "GatewayQosProfile",
+	"nsxt_policy_group":                                "Group",
+	"nsxt_policy_host_transport_node_profile":          "PolicyHostTransportNodeProfile",
+	"nsxt_policy_igmp_profile":                         "PolicyIgmpProfile",
+	"nsxt_policy_intrusion_service_policy":             "IdsSecurityPolicy",
+	"nsxt_policy_intrusion_service_profile":            "IdsProfile",
+	"nsxt_policy_ip_block":                             "IpAddressBlock",
+	"nsxt_policy_ip_discovery_profile":                 "IPDiscoveryProfile",
+	"nsxt_policy_ip_pool":                              "IpAddressPool",
+	"nsxt_policy_ipsec_vpn_dpd_profile":                "IPSecVpnDpdProfile",
+	"nsxt_policy_ipsec_vpn_ike_profile":                "IPSecVpnIkeProfile",
+	"nsxt_policy_ipsec_vpn_tunnel_profile":             "IPSecVpnTunnelProfile",
+	"nsxt_policy_lb_client_ssl_profile":                "LBClientSslProfile",
+	"nsxt_policy_lb_http_application_profile":          "LBHttpProfile",
+	"nsxt_policy_lb_http_monitor_profile":              "LBHttpMonitorProfile",
+	"nsxt_policy_lb_https_monitor_profile":             "LBHttpsMonitorProfile",
+	"nsxt_policy_lb_icmp_monitor_profile":              "LBIcmpMonitorProfile",
+	"nsxt_policy_lb_passive_monitor_profile":           "LBPassiveMonitorProfile",
+	"nsxt_policy_lb_pool":                              "LBPool",
+	"nsxt_policy_lb_service":                           "LBService",
+	"nsxt_policy_lb_tcp_monitor_profile":               "LBTcpMonitorProfile",
+	"nsxt_policy_lb_udp_monitor_profile":               "LBUdpMonitorProfile",
+	"nsxt_policy_lb_virtual_server":                    "LBVirtualServer",
+	"nsxt_policy_mac_discovery_profile":                policyMacDiscoveryProfileResource.resourceType,
+	"nsxt_policy_malware_prevention_profile":           "MalwarePreventionProfile",
+	"nsxt_policy_metadata_proxy":                       "MetadataProxyConfig",
+	"nsxt_policy_pim_profile":                          "PolicyPimProfile",
+	"nsxt_policy_project":                              "Project",
+	"nsxt_policy_qos_profile":                          "QoSProfile",
+	"nsxt_policy_redirection_policy":                   "RedirectionPolicy",
+	"nsxt_policy_security_policy":                      "SecurityPolicy",
+	"nsxt_policy_segment":                              "Segment",
+	"nsxt_policy_segment_security_profile":             "SegmentSecurityProfile",
+	"nsxt_policy_service":                              "Service",
+	"nsxt_policy_spoof_guard_profile":                  "SpoofGuardProfile",
+	"nsxt_policy_tier0_gateway":                        "Tier0",
+	"nsxt_policy_tier1_gateway":                        "Tier1",
+	"nsxt_policy_uplink_host_switch_profile":           infra.HostSwitchProfiles_LIST_HOSTSWITCH_PROFILE_TYPE_POLICYUPLINKHOSTSWITCHPROFILE,
+	"nsxt_policy_vtep_ha_host_switch_profile":          infra.HostSwitchProfiles_LIST_HOSTSWITCH_PROFILE_TYPE_POLICYVTEPHAHOSTSWITCHPROFILE,
+	"nsxt_vpc_gateway_policy":                          "GatewayPolicy",
+	"nsxt_vpc_group":                                   "Group",
+	"nsxt_vpc_security_policy":                         "SecurityPolicy",
+}
+
 type policySearchDataValue struct {
 	StructValue *data.StructValue
 	Resource    model.PolicyResource
@@ -137,6 +195,35 @@ func listInventoryResourcesByAnyFieldAndType(connector client.Connector, context
 	return searchLM(connector, *buildPolicyResourcesQuery(&query, additionalQuery))
 }
 
+// listPolicyResourcesByTypeAndPathPrefix lists objects of given type residing anywhere
+// under given policy path
+func listPolicyResourcesByTypeAndPathPrefix(connector client.Connector, context utl.SessionContext, resourceType string, pathPrefix string, additionalQuery *string) ([]*data.StructValue, error) {
+	query := fmt.Sprintf("resource_type:%s AND path:%s* AND marked_for_delete:false", resourceType, escapeSpecialCharacters(strings.TrimSuffix(pathPrefix, "/")+"/"))
+	switch context.ClientType {
+	case utl.Global:
+		return searchGMPolicyResources(connector, *buildPolicyResourcesQuery(&query, additionalQuery))
+	case utl.Local, utl.Multitenancy, utl.VPC:
+		// path prefix already restricts the search scope
+		return searchLM(connector, *buildPolicyResourcesQuery(&query, additionalQuery))
+	}
+
+	return nil, errors.New("invalid ClientType")
+}
+
+// searchPolicyResources runs search query restricted to objects of given context
+func searchPolicyResources(connector client.Connector, context utl.SessionContext, query string) ([]*data.StructValue, error) {
+	switch context.ClientType {
+	case utl.Local:
+		return searchLMPolicyResources(connector, query)
+	case utl.Global:
+		return searchGMPolicyResources(connector, query)
+	case utl.Multitenancy, utl.VPC:
+		return searchMultitenancyResources(connector, context, query)
+	}
+
+	return nil, errors.New("invalid ClientType")
+}
+
 func escapeSpecialCharacters(str string) string {
 	// we replace special characters that can be encountered in object IDs
 	specials := "()[]+-=&|><!{}^~*?:/"
@@ -205,7 +292,8 @@ func searchGMPolicyResources(connector client.Connector, query string) ([]*data.
 			total = int(*searchResponse.ResultCount)
 		}
 		cursor = searchResponse.Cursor
-		if len(results) >= total {
+		// guard against result count changing while paginating
+		if len(results) >= total || cursor == nil || len(searchResponse.Results) == 0 {
 			return results, nil
 		}
 	}
@@ -228,7 +316,8 @@ func searchLM(connector client.Connector, query string) ([]*data.StructValue, er
 			total = int(*searchResponse.ResultCount)
 		}
 		cursor = searchResponse.Cursor
-		if len(results) >= total {
+		// guard against result count changing while paginating
+		if len(results) >= total || cursor == nil || len(searchResponse.Results) == 0 {
 			return results, nil
 		}
 	}
@@ -48,6 +48,7 @@ type commonProviderConfig struct {
 	Username               string
 	Password               string
 	LicenseKeys            []string
+	TagGovernance          tagGovernanceConfig
 }
 
 type nsxtClients struct {
@@ -72,7 +73,7 @@ type nsxtClients struct {
 
 // Provider for VMWare NSX-T
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 
 		Schema: map[string]*schema.Schema{
 			"allow_unverified_ssl": {
@@ -241,6 +242,43 @@ func Provider() *schema.Provider {
 				Description: "Avoid initializing NSX connection on startup",
 				DefaultFunc: schema.EnvDefaultFunc("NSXT_ON_DEMAND_CONNECTION", false),
 			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Description: "Tags applied to all resources that support tags",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tag": getTagsSchemaInternal(true, false),
+					},
+				},
+			},
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Description: "Tags to be ignored on all resources that support tags",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scopes": {
+							Type:        schema.TypeList,
+							Description: "List of scopes to ignore",
+							Required:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"required_tag_scopes": {
+				Type:        schema.TypeList,
+				Description: "Tag scopes that all resources supporting tags are required to have",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -328,6 +366,23 @@ func Provider() *schema.Provider {
 			"nsxt_policy_gateway_flood_protection_profile":           dataSourceNsxtPolicyGatewayFloodProtectionProfile(),
 			"nsxt_manager_info":                                      dataSourceNsxtManagerInfo(),
 			"nsxt_vpc":                                               dataSourceNsxtVPC(),
+			"nsxt_policy_site_onboarding_conflicts":                  dataSourceNsxtPolicySiteOnboardingConflicts(),
+			"nsxt_upgrade_plan_report":                               dataSourceNsxtUpgradePlanReport(),
+			"nsxt_vcenter_cluster":                                   dataSourceNsxtVcenterCluster(),
+			"nsxt_policy_gateway_routing_table":                      dataSourceNsxtPolicyGatewayRoutingTable(),
+			"nsxt_policy_gateway_forwarding_table":                   dataSourceNsxtPolicyGatewayForwardingTable(),
+			"nsxt_policy_bgp_neighbor_status":                        dataSourceNsxtPolicyBgpNeighborStatus(),
+			"nsxt_policy_nat_rule_statistics":                        dataSourceNsxtPolicyNATRuleStatistics(),
+			"nsxt_policy_dhcp_leases":                                dataSourceNsxtPolicyDhcpLeases(),
+			"nsxt_policy_dhcp_pool_usage":                            dataSourceNsxtPolicyDhcpPoolUsage(),
+			"nsxt_policy_ip_pool_usage":                              dataSourceNsxtPolicyIPPoolUsage(),
+			"nsxt_policy_ip_block_usage":                             dataSourceNsxtPolicyIPBlockUsage(),
+			"nsxt_policy_firewall_identity_store_groups":             dataSourceNsxtPolicyFirewallIdentityStoreGroups(),
+			"nsxt_policy_malware_prevention_profile":                 dataSourceNsxtPolicyMalwarePreventionProfile(),
+			"nsxt_policy_intrusion_service_events":                   dataSourceNsxtPolicyIntrusionServiceEvents(),
+			"nsxt_policy_drift_report":                               dataSourceNsxtPolicyDriftReport(),
+			"nsxt_policy_objects":                                    dataSourceNsxtPolicyObjects(),
+			"nsxt_mp_policy_promotion":                               dataSourceNsxtMPPolicyPromotion(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -501,10 +556,29 @@ func Provider() *schema.Provider {
 			"nsxt_vpc_gateway_policy":                                  resourceNsxtVPCGatewayPolicy(),
 			"nsxt_policy_share":                                        resourceNsxtPolicyShare(),
 			"nsxt_policy_shared_resource":                              resourceNsxtPolicySharedResource(),
+			"nsxt_policy_site_onboarding":                              resourceNsxtPolicySiteOnboarding(),
+			"nsxt_policy_pim_profile":                                  resourceNsxtPolicyPimProfile(),
+			"nsxt_policy_igmp_profile":                                 resourceNsxtPolicyIgmpProfile(),
+			"nsxt_policy_gateway_route_aggregation":                    resourceNsxtPolicyGatewayRouteAggregation(),
+			"nsxt_policy_ospf_summary_address":                         resourceNsxtPolicyOspfSummaryAddress(),
+			"nsxt_policy_service_reference":                            resourceNsxtPolicyServiceReference(),
+			"nsxt_policy_service_profile":                              resourceNsxtPolicyServiceProfile(),
+			"nsxt_policy_service_chain":                                resourceNsxtPolicyServiceChain(),
+			"nsxt_policy_redirection_policy":                           resourceNsxtPolicyRedirectionPolicy(),
+			"nsxt_policy_firewall_identity_store":                      resourceNsxtPolicyFirewallIdentityStore(),
+			"nsxt_policy_idfw_cluster":                                 resourceNsxtPolicyIdfwCluster(),
+			"nsxt_policy_malware_prevention_profile":                   resourceNsxtPolicyMalwarePreventionProfile(),
+			"nsxt_service_deployment":                                  resourceNsxtServiceDeployment(),
+			"nsxt_policy_bulk_tags":                                    resourceNsxtPolicyBulkTags(),
 		},
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	addVersionValidation(provider.ResourcesMap)
+	addTagGovernance(provider.ResourcesMap)
+	addMPPolicyPromotionImport(provider.ResourcesMap)
+	return provider
 }
 
 func isVMCCredentialSet(d *schema.ResourceData) bool {
@@ -1071,6 +1145,7 @@ func initCommonConfig(d *schema.ResourceData) commonProviderConfig {
 		Username:               username,
 		Password:               password,
 		LicenseKeys:            licenses,
+		TagGovernance:          initTagGovernanceConfig(d),
 	}
 }
 
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
 	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -34,6 +35,58 @@ func init() {
 	}
 }
 
+// TestMain runs tests against offline NSX simulator when NSXT_TEST_SIMULATOR is set,
+// overriding NSX connection details in environment
+func TestMain(m *testing.M) {
+	if os.Getenv("NSXT_TEST_SIMULATOR") == "" {
+		os.Exit(m.Run())
+	}
+
+	server := simulator.NewServer(os.Getenv("NSXT_TEST_SIMULATOR_VERSION"))
+	os.Setenv("NSXT_MANAGER_HOST", server.Host())
+	os.Setenv("NSXT_USERNAME", "admin")
+	os.Setenv("NSXT_PASSWORD", "simulator")
+	os.Setenv("NSXT_ALLOW_UNVERIFIED_SSL", "true")
+
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
+
+// testSimulatorProvider starts NSX simulator reporting given version and returns
+// it along with provider configured against it. Provider configuration can be
+// extended with extraConfig. Simulator is closed and global NSX version restored
+// when the test completes.
+func testSimulatorProvider(t *testing.T, version string, extraConfig map[string]interface{}) (*simulator.Server, *schema.Provider) {
+	server := simulator.NewServer(version)
+	nsxVersion := util.NsxVersion
+	nsxVersionEstimated := util.NsxVersionEstimated
+	t.Cleanup(func() {
+		server.Close()
+		util.NsxVersion = nsxVersion
+		util.NsxVersionEstimated = nsxVersionEstimated
+	})
+	util.NsxVersion = ""
+	util.NsxVersionEstimated = false
+
+	config := map[string]interface{}{
+		"host":                 server.Host(),
+		"username":             "admin",
+		"password":             "simulator",
+		"allow_unverified_ssl": true,
+	}
+	for key, value := range extraConfig {
+		config[key] = value
+	}
+
+	provider := Provider()
+	diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(config))
+	if diags.HasError() {
+		t.Fatalf("Failed to configure provider: %v", diags)
+	}
+	return server, provider
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
@@ -18,8 +19,11 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/fabric"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/fabric/compute_managers"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/transport_nodes"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"golang.org/x/exp/maps"
 )
 
@@ -709,6 +713,88 @@ func getTransportNodeFromSchema(d *schema.ResourceData) (*model.TransportNode, e
 	return &obj, nil
 }
 
+// Validate vSphere placement of edge node VM against compute manager inventory, in order to fail before
+// the deployment is started rather than after the deployment times out
+func validateEdgeNodePlacement(connector client.Connector, d *schema.ResourceData) error {
+	var vdc map[string]interface{}
+	for _, ci := range d.Get("deployment_config").([]interface{}) {
+		c := ci.(map[string]interface{})
+		for _, vdci := range c["vm_deployment_config"].([]interface{}) {
+			vdc = vdci.(map[string]interface{})
+		}
+	}
+	if vdc == nil {
+		return nil
+	}
+	vcID := vdc["vc_id"].(string)
+	computeID := vdc["compute_id"].(string)
+	hostID := vdc["host_id"].(string)
+
+	var errs []string
+	statusClient := compute_managers.NewStatusClient(connector)
+	status, err := statusClient.Get(vcID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return fmt.Errorf("invalid edge placement: compute manager %s is not registered", vcID)
+		}
+		return logAPIError(fmt.Sprintf("Failed to retrieve status of compute manager %s", vcID), err)
+	}
+	if status.ConnectionStatus == nil || *status.ConnectionStatus != model.ComputeManagerStatus_CONNECTION_STATUS_UP {
+		errs = append(errs, fmt.Sprintf("compute manager %s is not connected", vcID))
+	}
+
+	// Compute ID could also be a resource pool, which is not part of NSX inventory
+	clusterExternalID := ""
+	if strings.HasPrefix(computeID, "domain-c") {
+		ccClient := fabric.NewComputeCollectionsClient(connector)
+		ccList, err := ccClient.List(&computeID, nil, nil, nil, nil, nil, nil, &vcID, nil, nil, nil, nil, nil)
+		if err != nil {
+			return logAPIError("Failed to retrieve compute collections", err)
+		}
+		if len(ccList.Results) == 0 {
+			errs = append(errs, fmt.Sprintf("cluster %s was not found in compute manager %s", computeID, vcID))
+		} else if ccList.Results[0].ExternalId != nil {
+			clusterExternalID = *ccList.Results[0].ExternalId
+		}
+	}
+
+	if hostID != "" {
+		nodeClient := fabric.NewDiscoveredNodesClient(connector)
+		nodeList, err := nodeClient.List(&hostID, nil, nil, nil, nil, nil, nil, nil, nil, &vcID, nil, nil, nil, nil)
+		if err != nil {
+			return logAPIError("Failed to retrieve discovered nodes", err)
+		}
+		if len(nodeList.Results) == 0 {
+			errs = append(errs, fmt.Sprintf("host %s was not found in compute manager %s", hostID, vcID))
+		} else if clusterExternalID != "" {
+			parent := nodeList.Results[0].ParentComputeCollection
+			if parent == nil || *parent != clusterExternalID {
+				errs = append(errs, fmt.Sprintf("host %s is not a member of cluster %s", hostID, computeID))
+			}
+		}
+	}
+
+	// Segment paths are validated against policy inventory, while portgroup and logical switch IDs are left to NSX
+	networkIDs := append([]string{vdc["management_network_id"].(string)}, interface2StringList(vdc["data_network_ids"].([]interface{}))...)
+	for _, networkID := range networkIDs {
+		if !strings.HasPrefix(networkID, "/infra/segments/") {
+			continue
+		}
+		segmentClient := infra.NewSegmentsClient(connector)
+		_, err := segmentClient.Get(getPolicyIDFromPath(networkID))
+		if isNotFoundError(err) {
+			errs = append(errs, fmt.Sprintf("segment %s was not found", networkID))
+		} else if err != nil {
+			return logAPIError(fmt.Sprintf("Failed to retrieve segment %s", networkID), err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid edge placement:\n%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
 func resourceNsxtEdgeTransportNodeCreate(d *schema.ResourceData, m interface{}) error {
 	connector := getPolicyConnector(m)
 	client := nsx.NewTransportNodesClient(connector)
@@ -718,6 +804,11 @@ func resourceNsxtEdgeTransportNodeCreate(d *schema.ResourceData, m interface{})
 		return err
 	}
 
+	err = validateEdgeNodePlacement(connector, d)
+	if err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Creating Transport Node with name %s", *obj.DisplayName)
 
 	obj1, err := client.Create(*obj)
@@ -1523,6 +1614,13 @@ func resourceNsxtEdgeTransportNodeUpdate(d *schema.ResourceData, m interface{})
 	revision := int64(d.Get("revision").(int))
 	obj.Revision = &revision
 
+	if d.HasChange("deployment_config") {
+		err = validateEdgeNodePlacement(connector, d)
+		if err != nil {
+			return err
+		}
+	}
+
 	_, err = client.Update(id, *obj, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		return handleUpdateError("TransportNode", id, err)
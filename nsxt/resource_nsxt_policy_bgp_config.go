@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	gm_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_0s/locale_services"
 	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services"
@@ -19,6 +20,7 @@ func resourceNsxtPolicyBgpConfig() *schema.Resource {
 	bgpSchema["site_path"] = getPolicyPathSchema(false, true, "Site Path for this BGP config")
 	bgpSchema["gateway_id"] = getComputedGatewayIDSchema()
 	bgpSchema["locale_service_id"] = getComputedLocaleServiceIDSchema()
+	bgpSchema["ignore_route_aggregation"].ConflictsWith = []string{"route_aggregation"}
 
 	return &schema.Resource{
 		Create: resourceNsxtPolicyBgpConfigCreate,
@@ -30,6 +32,24 @@ func resourceNsxtPolicyBgpConfig() *schema.Resource {
 	}
 }
 
+func getPolicyBgpConfig(connector client.Connector, isGlobalManager bool, gwID string, serviceID string) (model.BgpRoutingConfig, error) {
+	if isGlobalManager {
+		client := gm_locale_services.NewBgpClient(connector)
+		gmObj, err := client.Get(gwID, serviceID)
+		if err != nil {
+			return model.BgpRoutingConfig{}, err
+		}
+		lmObj, convErr := convertModelBindingType(gmObj, gm_model.BgpRoutingConfigBindingType(), model.BgpRoutingConfigBindingType())
+		if convErr != nil {
+			return model.BgpRoutingConfig{}, convErr
+		}
+		return lmObj.(model.BgpRoutingConfig), nil
+	}
+
+	client := locale_services.NewBgpClient(connector)
+	return client.Get(gwID, serviceID)
+}
+
 func resourceNsxtPolicyBgpConfigRead(d *schema.ResourceData, m interface{}) error {
 	connector := getPolicyConnector(m)
 
@@ -39,29 +59,12 @@ func resourceNsxtPolicyBgpConfigRead(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Tier0 Gateway path expected, got %s", gwPath)
 	}
 	serviceID := d.Get("locale_service_id").(string)
-	var lmRoutingConfig model.BgpRoutingConfig
-	if isPolicyGlobalManager(m) {
-
-		client := gm_locale_services.NewBgpClient(connector)
-		gmObj, err := client.Get(gwID, serviceID)
-		if err != nil {
-			return handleReadError(d, "BGP Config", serviceID, err)
-		}
-		lmObj, convErr := convertModelBindingType(gmObj, gm_model.BgpRoutingConfigBindingType(), model.BgpRoutingConfigBindingType())
-		if convErr != nil {
-			return convErr
-		}
-		lmRoutingConfig = lmObj.(model.BgpRoutingConfig)
-	} else {
-		var err error
-		client := locale_services.NewBgpClient(connector)
-		lmRoutingConfig, err = client.Get(gwID, serviceID)
-		if err != nil {
-			return handleReadError(d, "BGP Config", serviceID, err)
-		}
+	lmRoutingConfig, err := getPolicyBgpConfig(connector, isPolicyGlobalManager(m), gwID, serviceID)
+	if err != nil {
+		return handleReadError(d, "BGP Config", serviceID, err)
 	}
 
-	data := initPolicyTier0BGPConfigMap(&lmRoutingConfig)
+	data := initPolicyTier0BGPConfigMap(&lmRoutingConfig, d.Get("ignore_route_aggregation").(bool))
 
 	for key, value := range data {
 		d.Set(key, value)
@@ -217,6 +220,14 @@ func resourceNsxtPolicyBgpConfigUpdate(d *schema.ResourceData, m interface{}) er
 	}
 
 	obj.Revision = &revision
+	if d.Get("ignore_route_aggregation").(bool) {
+		// Update replaces the whole config, hence aggregations configured on NSX are sent back
+		existing, err := getPolicyBgpConfig(connector, isPolicyGlobalManager(m), gwID, serviceID)
+		if err != nil {
+			return handleUpdateError("BgpRoutingConfig", gwID, err)
+		}
+		obj.RouteAggregations = existing.RouteAggregations
+	}
 	if isPolicyGlobalManager(m) {
 		gmObj, convErr := convertModelBindingType(obj, model.BgpRoutingConfigBindingType(), gm_model.BgpRoutingConfigBindingType())
 		if convErr != nil {
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var accTestPolicyBgpConfigCreateAttributes = map[string]string{
@@ -159,3 +160,46 @@ data "nsxt_policy_realization_info" "realization_info" {
   %s
 }`, extraConfig, extraConfig)
 }
+
+func TestPolicyBgpConfigIgnoreRouteAggregation(t *testing.T) {
+	server, provider := testSimulatorProvider(t, "", nil)
+
+	bgpPath := "/infra/tier-0s/t0/locale-services/default/bgp"
+	aggregation := map[string]interface{}{"prefix": "20.1.0.0/16", "summary_only": true}
+	objects := map[string]map[string]interface{}{
+		"/infra/tier-0s/t0":                         {"resource_type": "Tier0"},
+		"/infra/tier-0s/t0/locale-services/default": {"resource_type": "LocaleServices"},
+		bgpPath: {"resource_type": "BgpRoutingConfig", "route_aggregations": []interface{}{aggregation}},
+	}
+	for path, obj := range objects {
+		if err := server.Put(path, obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	res := resourceNsxtPolicyBgpConfig()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"gateway_path":             "/infra/tier-0s/t0",
+		"ignore_route_aggregation": true,
+	})
+	d.SetId("bgp")
+	d.Set("locale_service_id", "default")
+	if err := res.Read(d, provider.Meta()); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("route_aggregation.#").(int) != 0 || !d.Get("ignore_route_aggregation").(bool) {
+		t.Errorf("Expected route aggregations to be ignored, got %v", d.Get("route_aggregation"))
+	}
+
+	d.Set("local_as_num", "65001")
+	if err := res.Update(d, provider.Meta()); err != nil {
+		t.Fatal(err)
+	}
+	obj, _ := server.Get(bgpPath)
+	if obj["local_as_num"] != "65001" {
+		t.Errorf("Expected BGP config to be updated, got %v", obj)
+	}
+	if aggregations, ok := obj["route_aggregations"].([]interface{}); !ok || len(aggregations) != 1 {
+		t.Errorf("Expected route aggregations to be preserved, got %v", obj["route_aggregations"])
+	}
+}
@@ -0,0 +1,664 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tags"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tags/tag_operations"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+const (
+	policyBulkTagsVMResourceType = "VirtualMachine"
+	// number of paths resolved in a single search query
+	policyBulkTagsSearchBatchSize = 50
+	policyBulkTagsTimeout         = 600
+)
+
+var policyBulkTagsTargetAttributes = []string{"target_paths", "target_vm_ids", "target_query"}
+
+func resourceNsxtPolicyBulkTags() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNsxtPolicyBulkTagsCreate,
+		Read:          resourceNsxtPolicyBulkTagsRead,
+		Update:        resourceNsxtPolicyBulkTagsUpdate,
+		Delete:        resourceNsxtPolicyBulkTagsDelete,
+		CustomizeDiff: resourceNsxtPolicyBulkTagsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"tag": getTagsSchemaInternal(true, false),
+			"target_paths": {
+				Type:        schema.TypeSet,
+				Description: "Policy paths of objects to be tagged",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePolicyPath(),
+				},
+				AtLeastOneOf: policyBulkTagsTargetAttributes,
+			},
+			"target_vm_ids": {
+				Type:        schema.TypeSet,
+				Description: "External IDs of virtual machines to be tagged",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				AtLeastOneOf: policyBulkTagsTargetAttributes,
+			},
+			"target_query": {
+				Type:         schema.TypeString,
+				Description:  "NSX search query selecting objects to be tagged",
+				Optional:     true,
+				AtLeastOneOf: policyBulkTagsTargetAttributes,
+			},
+			"context": getContextSchema(false, false, false),
+			"targets": {
+				Type:        schema.TypeSet,
+				Description: "Objects selected by target attributes, identified by policy path or virtual machine external ID",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"tagged_targets": {
+				Type:        schema.TypeSet,
+				Description: "Targets that carry all tags managed by this resource, identified by policy path or virtual machine external ID",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"members": {
+				Type:        schema.TypeList,
+				Description: "Objects tagged by this resource per tag, objects that already carried the tag are not included",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"objects": {
+							Type:        schema.TypeSet,
+							Description: "Objects identified by policy path or virtual machine external ID",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// policyBulkTagsObject is an object found by search, identified by external ID for
+// virtual machines and policy path otherwise, as expected by tag bulk operation
+type policyBulkTagsObject struct {
+	id           string
+	resourceType string
+	tags         []gm_model.Tag
+}
+
+func convertPolicyBulkTagsSearchResults(results []*data.StructValue) ([]policyBulkTagsObject, error) {
+	var objects []policyBulkTagsObject
+	converter := bindings.NewTypeConverter()
+	for _, result := range results {
+		dataValue, errs := converter.ConvertToGolang(result, gm_model.PolicyResourceBindingType())
+		if len(errs) > 0 {
+			return nil, errs[0]
+		}
+		obj := dataValue.(gm_model.PolicyResource)
+		if obj.ResourceType == nil {
+			continue
+		}
+
+		id := ""
+		if *obj.ResourceType == policyBulkTagsVMResourceType {
+			vms, err := convertSearchResultToVMList([]*data.StructValue{result})
+			if err != nil {
+				return nil, err
+			}
+			if vms[0].ExternalId != nil {
+				id = *vms[0].ExternalId
+			}
+		} else if obj.Path != nil {
+			id = *obj.Path
+		}
+		if id == "" {
+			continue
+		}
+		objects = append(objects, policyBulkTagsObject{id: id, resourceType: *obj.ResourceType, tags: obj.Tags})
+	}
+	return objects, nil
+}
+
+func policyBulkTagsObjectHasTag(obj policyBulkTagsObject, tag model.Tag) bool {
+	for _, objTag := range obj.tags {
+		if policyTagsEqual(objTag.Scope, tag.Scope) && policyTagsEqual(objTag.Tag, tag.Tag) {
+			return true
+		}
+	}
+	return false
+}
+
+func policyTagsEqual(a *string, b *string) bool {
+	valueA := ""
+	valueB := ""
+	if a != nil {
+		valueA = *a
+	}
+	if b != nil {
+		valueB = *b
+	}
+	return valueA == valueB
+}
+
+// resolvePolicyBulkTagsPaths returns resource types of objects with given paths,
+// objects that do not exist are omitted
+func resolvePolicyBulkTagsPaths(connector client.Connector, paths []string) (map[string]string, error) {
+	result := make(map[string]string)
+	for start := 0; start < len(paths); start += policyBulkTagsSearchBatchSize {
+		end := start + policyBulkTagsSearchBatchSize
+		if end > len(paths) {
+			end = len(paths)
+		}
+		var terms []string
+		for _, path := range paths[start:end] {
+			terms = append(terms, "path:"+escapeSpecialCharacters(path))
+		}
+		results, err := searchLM(connector, fmt.Sprintf("(%s) AND marked_for_delete:false", strings.Join(terms, " OR ")))
+		if err != nil {
+			return nil, err
+		}
+		objects, err := convertPolicyBulkTagsSearchResults(results)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objects {
+			result[obj.id] = obj.resourceType
+		}
+	}
+	return result, nil
+}
+
+// resolvePolicyBulkTagsObjects returns resource types of objects identified by policy
+// path or virtual machine external ID, objects with paths that do not exist are omitted
+func resolvePolicyBulkTagsObjects(connector client.Connector, ids []string) (map[string]string, error) {
+	var paths []string
+	result := make(map[string]string)
+	for _, id := range ids {
+		if strings.HasPrefix(id, "/") {
+			paths = append(paths, id)
+		} else {
+			result[id] = policyBulkTagsVMResourceType
+		}
+	}
+	objects, err := resolvePolicyBulkTagsPaths(connector, paths)
+	if err != nil {
+		return nil, err
+	}
+	for id, resourceType := range objects {
+		result[id] = resourceType
+	}
+	return result, nil
+}
+
+// resolvePolicyBulkTagsTargets returns resource types of objects selected by target
+// attributes, keyed by object identifier
+func resolvePolicyBulkTagsTargets(d *schema.ResourceData, m interface{}) (map[string]string, error) {
+	connector := getPolicyConnector(m)
+	targets, err := resolvePolicyBulkTagsPaths(connector, interface2StringList(d.Get("target_paths").(*schema.Set).List()))
+	if err != nil {
+		return nil, err
+	}
+	for _, vmID := range interface2StringList(d.Get("target_vm_ids").(*schema.Set).List()) {
+		targets[vmID] = policyBulkTagsVMResourceType
+	}
+
+	if query := d.Get("target_query").(string); query != "" {
+		var results []*data.StructValue
+		context := getSessionContext(d, m)
+		query = fmt.Sprintf("(%s) AND marked_for_delete:false", query)
+		if context.ClientType == utl.Local {
+			// virtual machines have no policy path, hence search is not restricted to infra
+			results, err = searchLM(connector, query)
+		} else {
+			results, err = searchPolicyResources(connector, context, query)
+		}
+		if err != nil {
+			return nil, err
+		}
+		objects, err := convertPolicyBulkTagsSearchResults(results)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objects {
+			targets[obj.id] = obj.resourceType
+		}
+	}
+	return targets, nil
+}
+
+// policyBulkTagKey identifies a tag, since a tag bulk operation handles a single tag
+type policyBulkTagKey struct {
+	scope string
+	tag   string
+}
+
+func getPolicyBulkTagKey(tag model.Tag) policyBulkTagKey {
+	key := policyBulkTagKey{}
+	if tag.Scope != nil {
+		key.scope = *tag.Scope
+	}
+	if tag.Tag != nil {
+		key.tag = *tag.Tag
+	}
+	return key
+}
+
+// listPolicyBulkTagsTagged returns identifiers of objects that carry each of given tags.
+// Tags with neither scope nor value can not be searched for, and are omitted.
+func listPolicyBulkTagsTagged(connector client.Connector, tagList []model.Tag) (map[policyBulkTagKey]map[string]bool, error) {
+	result := make(map[policyBulkTagKey]map[string]bool)
+	for _, tag := range tagList {
+		var terms []string
+		if tag.Scope != nil && *tag.Scope != "" {
+			terms = append(terms, "tags.scope:"+escapeSpecialCharacters(*tag.Scope))
+		}
+		if tag.Tag != nil && *tag.Tag != "" {
+			terms = append(terms, "tags.tag:"+escapeSpecialCharacters(*tag.Tag))
+		}
+		if len(terms) == 0 {
+			continue
+		}
+		results, err := searchLM(connector, strings.Join(terms, " AND "))
+		if err != nil {
+			return nil, err
+		}
+		objects, err := convertPolicyBulkTagsSearchResults(results)
+		if err != nil {
+			return nil, err
+		}
+
+		tagged := make(map[string]bool)
+		for _, obj := range objects {
+			if policyBulkTagsObjectHasTag(obj, tag) {
+				tagged[obj.id] = true
+			}
+		}
+		result[getPolicyBulkTagKey(tag)] = tagged
+	}
+	return result, nil
+}
+
+// getPolicyBulkTagsMembersFromSchema returns objects tagged by this resource, per tag
+func getPolicyBulkTagsMembersFromSchema(value interface{}) map[policyBulkTagKey][]string {
+	members := make(map[policyBulkTagKey][]string)
+	for _, item := range value.([]interface{}) {
+		data := item.(map[string]interface{})
+		key := policyBulkTagKey{scope: data["scope"].(string), tag: data["tag"].(string)}
+		members[key] = interface2StringList(data["objects"].(*schema.Set).List())
+	}
+	return members
+}
+
+func setPolicyBulkTagsMembersInSchema(d *schema.ResourceData, members map[policyBulkTagKey][]string) error {
+	var keys []policyBulkTagKey
+	for key, objects := range members {
+		if len(objects) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].scope != keys[j].scope {
+			return keys[i].scope < keys[j].scope
+		}
+		return keys[i].tag < keys[j].tag
+	})
+
+	var result []map[string]interface{}
+	for _, key := range keys {
+		result = append(result, map[string]interface{}{
+			"scope":   key.scope,
+			"tag":     key.tag,
+			"objects": members[key],
+		})
+	}
+	return d.Set("members", result)
+}
+
+func getPolicyBulkTagsResourceInfo(objects map[string]string) []model.ResourceInfo {
+	idsByType := make(map[string][]string)
+	for id, resourceType := range objects {
+		idsByType[resourceType] = append(idsByType[resourceType], id)
+	}
+	var resourceTypes []string
+	for resourceType := range idsByType {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	var result []model.ResourceInfo
+	for _, resourceType := range resourceTypes {
+		ids := idsByType[resourceType]
+		sort.Strings(ids)
+		result = append(result, model.ResourceInfo{
+			ResourceType: &resourceType,
+			ResourceIds:  ids,
+		})
+	}
+	return result
+}
+
+func getPolicyBulkTagsStatusError(status model.TagBulkOperationStatus) error {
+	var details []string
+	for _, typeStatus := range append(status.ApplyTo, status.RemoveFrom...) {
+		for _, item := range typeStatus.ResourceTagStatus {
+			if item.TagStatus == nil || *item.TagStatus != model.ResourceTagStatus_TAG_STATUS_ERROR {
+				continue
+			}
+			message := "unknown error"
+			if item.Details != nil {
+				message = *item.Details
+			}
+			id := ""
+			if item.ResourceId != nil {
+				id = *item.ResourceId
+			}
+			details = append(details, fmt.Sprintf("%s: %s", id, message))
+		}
+	}
+	return fmt.Errorf("Tag bulk operation failed: %s", strings.Join(details, "; "))
+}
+
+// applyPolicyBulkTagOperation assigns tag to and removes tag from objects, keyed by
+// object identifier with resource type as value, and waits for operation to complete.
+// Each call submits a new operation, since status of an operation reflects its latest
+// submission only. Completed operations are left to NSX, as API does not support
+// deleting them.
+func applyPolicyBulkTagOperation(connector client.Connector, tag model.Tag, applyTo map[string]string, removeFrom map[string]string) error {
+	if len(applyTo) == 0 && len(removeFrom) == 0 {
+		return nil
+	}
+
+	operationID := newUUID()
+	operation := model.TagBulkOperation{
+		Tag:        &tag,
+		ApplyTo:    getPolicyBulkTagsResourceInfo(applyTo),
+		RemoveFrom: getPolicyBulkTagsResourceInfo(removeFrom),
+	}
+	log.Printf("[INFO] Applying tag bulk operation %s to %d objects, removing from %d objects", operationID, len(applyTo), len(removeFrom))
+	client := tags.NewTagOperationsClient(connector)
+	if _, err := client.Update(operationID, operation, nil, nil, nil, nil, nil, nil, nil); err != nil {
+		return err
+	}
+
+	statusClient := tag_operations.NewStatusClient(connector)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{model.TagBulkOperationStatus_STATUS_PENDING, model.TagBulkOperationStatus_STATUS_RUNNING},
+		Target:  []string{model.TagBulkOperationStatus_STATUS_SUCCESS},
+		Refresh: func() (interface{}, string, error) {
+			status, err := statusClient.Get(operationID, nil, nil, nil, nil, nil, nil, nil)
+			if err != nil {
+				return status, model.TagBulkOperationStatus_STATUS_ERROR, logAPIError("Error getting tag bulk operation status", err)
+			}
+			if status.Status == nil {
+				return status, model.TagBulkOperationStatus_STATUS_PENDING, nil
+			}
+			log.Printf("[DEBUG] Current status of tag bulk operation %s is %s", operationID, *status.Status)
+			if *status.Status == model.TagBulkOperationStatus_STATUS_ERROR {
+				return status, *status.Status, getPolicyBulkTagsStatusError(status)
+			}
+			return status, *status.Status, nil
+		},
+		Timeout:    policyBulkTagsTimeout * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+// updatePolicyBulkTags applies tags to current targets that lack them, and removes tags
+// from objects this resource has tagged that are no longer targeted, or with tags no longer
+// configured. Objects that carried a tag before it was applied are not members, hence the
+// tag is never removed from them. Other tags on target objects are not affected.
+// Members are recorded even when an operation fails, so that objects tagged so far are
+// cleaned up on subsequent apply or destroy.
+func updatePolicyBulkTags(d *schema.ResourceData, m interface{}, oldTags []model.Tag, newTags []model.Tag, oldMembers map[policyBulkTagKey][]string) error {
+	members := make(map[policyBulkTagKey][]string)
+	for key, objects := range oldMembers {
+		members[key] = objects
+	}
+	err := applyPolicyBulkTags(d, m, oldTags, newTags, members)
+	if setErr := setPolicyBulkTagsMembersInSchema(d, members); setErr != nil && err == nil {
+		return setErr
+	}
+	return err
+}
+
+// applyPolicyBulkTags runs tag bulk operations, updating members as operations complete.
+// While an operation is in progress, both objects it tags and objects it untags are
+// considered members. If operation fails, members that do not carry the tag are dropped.
+func applyPolicyBulkTags(d *schema.ResourceData, m interface{}, oldTags []model.Tag, newTags []model.Tag, members map[policyBulkTagKey][]string) error {
+	connector := getPolicyConnector(m)
+	targets, err := resolvePolicyBulkTagsTargets(d, m)
+	if err != nil {
+		return err
+	}
+	tagged, err := listPolicyBulkTagsTagged(connector, newTags)
+	if err != nil {
+		return err
+	}
+
+	isNewTag := make(map[policyBulkTagKey]bool)
+	for _, tag := range newTags {
+		key := getPolicyBulkTagKey(tag)
+		isNewTag[key] = true
+
+		var keptIDs, removedIDs []string
+		for _, member := range members[key] {
+			if _, ok := targets[member]; ok {
+				keptIDs = append(keptIDs, member)
+			} else {
+				removedIDs = append(removedIDs, member)
+			}
+		}
+		// objects deleted meanwhile are not resolved, and need no cleanup
+		removeFrom, err := resolvePolicyBulkTagsObjects(connector, removedIDs)
+		if err != nil {
+			return err
+		}
+
+		applyTo := make(map[string]string)
+		var appliedIDs []string
+		for id, resourceType := range targets {
+			if !tagged[key][id] {
+				applyTo[id] = resourceType
+				appliedIDs = append(appliedIDs, id)
+			}
+		}
+		inProgress := append(append([]string{}, keptIDs...), removedIDs...)
+		members[key] = append(inProgress, appliedIDs...)
+		if err := applyPolicyBulkTagOperation(connector, tag, applyTo, removeFrom); err != nil {
+			// operation might be partially applied, keep members that carry the tag
+			if current, listErr := listPolicyBulkTagsTagged(connector, []model.Tag{tag}); listErr == nil {
+				if objects, ok := current[key]; ok {
+					var taggedIDs []string
+					for _, member := range members[key] {
+						if objects[member] {
+							taggedIDs = append(taggedIDs, member)
+						}
+					}
+					members[key] = taggedIDs
+				}
+			}
+			return err
+		}
+		members[key] = append(keptIDs, appliedIDs...)
+	}
+
+	for _, tag := range oldTags {
+		key := getPolicyBulkTagKey(tag)
+		if isNewTag[key] {
+			continue
+		}
+		removeFrom, err := resolvePolicyBulkTagsObjects(connector, members[key])
+		if err != nil {
+			return err
+		}
+		if err := applyPolicyBulkTagOperation(connector, tag, nil, removeFrom); err != nil {
+			return err
+		}
+		delete(members, key)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyBulkTagsCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	id := newUUID()
+	d.SetId(id)
+
+	// On failure, ID is kept along with members tagged so far, so that resource is
+	// tainted and tags are removed from members on destroy
+	newTags := getPolicyTagsFromSet(d.Get("tag").(*schema.Set))
+	if err := updatePolicyBulkTags(d, m, nil, newTags, nil); err != nil {
+		return handleCreateError("Policy Bulk Tags", id, err)
+	}
+
+	return resourceNsxtPolicyBulkTagsRead(d, m)
+}
+
+func resourceNsxtPolicyBulkTagsRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Policy Bulk Tags ID")
+	}
+
+	targets, err := resolvePolicyBulkTagsTargets(d, m)
+	if err != nil {
+		return handleReadError(d, "Policy Bulk Tags", id, err)
+	}
+	tagList := getPolicyTagsFromSet(d.Get("tag").(*schema.Set))
+	tagged, err := listPolicyBulkTagsTagged(connector, tagList)
+	if err != nil {
+		return handleReadError(d, "Policy Bulk Tags", id, err)
+	}
+
+	// Targets that lost tags outside of terraform are tagged again on next apply,
+	// and objects that lost the tag are no longer members
+	var targetList []string
+	var taggedTargetList []string
+	for target := range targets {
+		targetList = append(targetList, target)
+		isTagged := true
+		for _, tag := range tagList {
+			if objects, ok := tagged[getPolicyBulkTagKey(tag)]; ok && !objects[target] {
+				isTagged = false
+			}
+		}
+		if isTagged {
+			taggedTargetList = append(taggedTargetList, target)
+		}
+	}
+	oldMembers := getPolicyBulkTagsMembersFromSchema(d.Get("members"))
+	members := make(map[policyBulkTagKey][]string)
+	for _, tag := range tagList {
+		key := getPolicyBulkTagKey(tag)
+		for _, member := range oldMembers[key] {
+			if objects, ok := tagged[key]; !ok || objects[member] {
+				members[key] = append(members[key], member)
+			}
+		}
+	}
+
+	d.Set("targets", targetList)
+	d.Set("tagged_targets", taggedTargetList)
+	if err := setPolicyBulkTagsMembersInSchema(d, members); err != nil {
+		return handleReadError(d, "Policy Bulk Tags", id, err)
+	}
+	return nil
+}
+
+func resourceNsxtPolicyBulkTagsUpdate(d *schema.ResourceData, m interface{}) error {
+	oldValue, newValue := d.GetChange("tag")
+	oldTags := getPolicyTagsFromSet(oldValue.(*schema.Set))
+	newTags := getPolicyTagsFromSet(newValue.(*schema.Set))
+	oldMembers, _ := d.GetChange("members")
+
+	if err := updatePolicyBulkTags(d, m, oldTags, newTags, getPolicyBulkTagsMembersFromSchema(oldMembers)); err != nil {
+		return handleUpdateError("Policy Bulk Tags", d.Id(), err)
+	}
+
+	return resourceNsxtPolicyBulkTagsRead(d, m)
+}
+
+func resourceNsxtPolicyBulkTagsDelete(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	members := getPolicyBulkTagsMembersFromSchema(d.Get("members"))
+
+	for _, tag := range getPolicyTagsFromSet(d.Get("tag").(*schema.Set)) {
+		removeFrom, err := resolvePolicyBulkTagsObjects(connector, members[getPolicyBulkTagKey(tag)])
+		if err != nil {
+			return handleDeleteError("Policy Bulk Tags", d.Id(), err)
+		}
+		if err := applyPolicyBulkTagOperation(connector, tag, nil, removeFrom); err != nil {
+			return handleDeleteError("Policy Bulk Tags", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// resourceNsxtPolicyBulkTagsCustomizeDiff plans an update when targets, as detected
+// on last refresh, lack tags
+func resourceNsxtPolicyBulkTagsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.HasChanges(policyBulkTagsTargetAttributes...) || d.HasChanges("tag", "context") {
+		for _, attr := range []string{"targets", "tagged_targets", "members"} {
+			if err := d.SetNewComputed(attr); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	targets := d.Get("targets").(*schema.Set)
+	taggedTargets := d.Get("tagged_targets").(*schema.Set)
+	if !targets.Equal(taggedTargets) {
+		if err := d.SetNewComputed("tagged_targets"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("members")
+	}
+	return nil
+}
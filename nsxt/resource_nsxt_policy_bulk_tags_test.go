@@ -0,0 +1,235 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestAccResourceNsxtPolicyBulkTags_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	vmID := getTestVMID()
+	testResourceName := "nsxt_policy_bulk_tags.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.1.2")
+			testAccEnvDefined(t, "NSXT_TEST_VM_ID")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyBulkTagsTemplate(name, vmID, 2, "blue"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "targets.#", "3"),
+					resource.TestCheckResourceAttr(testResourceName, "tagged_targets.#", "3"),
+					resource.TestCheckResourceAttr(testResourceName, "members.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "members.0.objects.#", "3"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyBulkTagsTemplate(name, vmID, 1, "green"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "targets.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "tagged_targets.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "members.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "members.0.objects.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyBulkTagsTemplate(name string, vmID string, segmentCount int, tag string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_segment" "test" {
+  count        = 2
+  display_name = "%s-${count.index}"
+}
+
+resource "nsxt_policy_bulk_tags" "test" {
+  target_paths  = slice(nsxt_policy_segment.test[*].path, 0, %d)
+  target_vm_ids = ["%s"]
+
+  tag {
+    scope = "bulk-test"
+    tag   = "%s"
+  }
+}`, name, segmentCount, vmID, tag)
+}
+
+func getSimulatorObjectTagValues(server *simulator.Server, key string, scope string) []string {
+	obj, _ := server.Get(key)
+	var values []string
+	tags, _ := obj["tags"].([]interface{})
+	for _, tag := range tags {
+		data := tag.(map[string]interface{})
+		if data["scope"] == scope {
+			values = append(values, data["tag"].(string))
+		}
+	}
+	return values
+}
+
+func TestPolicyBulkTagsLifecycle(t *testing.T) {
+	server, provider := testSimulatorProvider(t, "", nil)
+
+	foreignTag := map[string]interface{}{"scope": "owner", "tag": "netops"}
+	devTag := map[string]interface{}{"scope": "env", "tag": "dev"}
+	for _, id := range []string{"s1", "s2", "s3"} {
+		obj := map[string]interface{}{"tags": []interface{}{foreignTag}}
+		if id == "s3" {
+			// tag assigned before the resource was created
+			obj["tags"] = []interface{}{foreignTag, devTag}
+		}
+		if err := server.Put("/infra/segments/"+id, obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	vmKey := "/api/v1/fabric/virtual-machines/vm1"
+	if err := server.Put(vmKey, map[string]interface{}{"resource_type": "VirtualMachine", "external_id": "vm-ext-1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := server.Put("/infra/domains/default/groups/web", map[string]interface{}{"tags": []interface{}{map[string]interface{}{"scope": "app", "tag": "web"}}}); err != nil {
+		t.Fatal(err)
+	}
+	m := provider.Meta()
+
+	res := provider.ResourcesMap["nsxt_policy_bulk_tags"]
+	if res.Schema["tags_all"] != nil {
+		t.Errorf("Expected bulk tags to be excluded from tag governance")
+	}
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"target_paths":  []interface{}{"/infra/segments/s1", "/infra/segments/s2"},
+		"target_vm_ids": []interface{}{"vm-ext-1"},
+		"target_query":  "resource_type:Group AND tags.tag:web",
+		"tag":           []interface{}{map[string]interface{}{"scope": "env", "tag": "prod"}},
+	})
+	if err := res.Create(d, m); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"/infra/segments/s1", "/infra/segments/s2", vmKey, "/infra/domains/default/groups/web"} {
+		if values := getSimulatorObjectTagValues(server, key, "env"); len(values) != 1 || values[0] != "prod" {
+			t.Errorf("Expected %s to be tagged, got %v", key, values)
+		}
+	}
+	if d.Get("targets.#").(int) != 4 || d.Get("tagged_targets.#").(int) != 4 || d.Get("members.0.objects.#").(int) != 4 {
+		t.Errorf("Unexpected state after create: targets %v, tagged targets %v, members %v", d.Get("targets"), d.Get("tagged_targets"), d.Get("members"))
+	}
+
+	// tag removed outside of terraform is detected
+	obj, _ := server.Get("/infra/segments/s2")
+	obj["tags"] = []interface{}{foreignTag}
+	if err := server.Put("/infra/segments/s2", obj); err != nil {
+		t.Fatal(err)
+	}
+	if err := res.Read(d, m); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("tagged_targets.#").(int) != 3 || d.Get("members.0.objects.#").(int) != 3 {
+		t.Errorf("Expected untagged target to be excluded from members, got %v", d.Get("members"))
+	}
+
+	state := d.State()
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"target_paths":  []interface{}{"/infra/segments/s2", "/infra/segments/s3"},
+		"target_vm_ids": []interface{}{"vm-ext-1"},
+		"tag":           []interface{}{map[string]interface{}{"scope": "env", "tag": "dev"}},
+	}), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err = schema.InternalMap(res.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := res.Update(d, m); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"/infra/segments/s1":                "",
+		"/infra/segments/s2":                "dev",
+		"/infra/segments/s3":                "dev",
+		vmKey:                               "dev",
+		"/infra/domains/default/groups/web": "",
+	}
+	for key, value := range expected {
+		values := getSimulatorObjectTagValues(server, key, "env")
+		if (value == "" && len(values) != 0) || (value != "" && (len(values) != 1 || values[0] != value)) {
+			t.Errorf("Expected %s to be tagged with %q, got %v", key, value, values)
+		}
+		if owner := getSimulatorObjectTagValues(server, key, "owner"); strings.HasPrefix(key, "/infra/segments/") && len(owner) != 1 {
+			t.Errorf("Expected foreign tag on %s to be preserved, got %v", key, owner)
+		}
+	}
+	if d.Get("tagged_targets.#").(int) != 3 || d.Get("members.#").(int) != 1 || d.Get("members.0.objects.#").(int) != 2 {
+		t.Errorf("Expected target that already carried the tag to be excluded from members, got %v", d.Get("members"))
+	}
+
+	if err := res.Delete(d, m); err != nil {
+		t.Fatal(err)
+	}
+	for key := range expected {
+		values := getSimulatorObjectTagValues(server, key, "env")
+		if key == "/infra/segments/s3" {
+			if len(values) != 1 {
+				t.Errorf("Expected tag assigned before the resource was created to be preserved, got %v", values)
+			}
+		} else if len(values) != 0 {
+			t.Errorf("Expected tags on %s to be removed, got %v", key, values)
+		}
+	}
+}
+
+func TestPolicyBulkTagsCreatePartialFailure(t *testing.T) {
+	server, provider := testSimulatorProvider(t, "", nil)
+
+	if err := server.Put("/infra/segments/s1", map[string]interface{}{}); err != nil {
+		t.Fatal(err)
+	}
+	m := provider.Meta()
+
+	res := provider.ResourcesMap["nsxt_policy_bulk_tags"]
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"target_paths":  []interface{}{"/infra/segments/s1"},
+		"target_vm_ids": []interface{}{"vm-missing"},
+		"tag":           []interface{}{map[string]interface{}{"scope": "env", "tag": "prod"}},
+	})
+	if err := res.Create(d, m); err == nil || !strings.Contains(err.Error(), "vm-missing") {
+		t.Fatalf("Expected create to fail on missing virtual machine, got %v", err)
+	}
+	if d.Id() == "" {
+		t.Fatalf("Expected ID to be kept after partial failure")
+	}
+	if _, ok := server.Get("/infra/tags/tag-operations/" + d.Id()); ok {
+		t.Errorf("Expected tag bulk operation ID to differ from resource ID")
+	}
+	if values := getSimulatorObjectTagValues(server, "/infra/segments/s1", "env"); len(values) != 1 {
+		t.Errorf("Expected segment to be tagged, got %v", values)
+	}
+	members := interface2StringList(d.Get("members.0.objects").(*schema.Set).List())
+	if len(members) != 1 || members[0] != "/infra/segments/s1" {
+		t.Errorf("Expected only tagged segment to be kept in members, got %v", members)
+	}
+
+	if err := res.Delete(d, m); err != nil {
+		t.Fatal(err)
+	}
+	if values := getSimulatorObjectTagValues(server, "/infra/segments/s1", "env"); len(values) != 0 {
+		t.Errorf("Expected tag to be removed on destroy, got %v", values)
+	}
+}
@@ -0,0 +1,412 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/firewall_identity_stores"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var firewallIdentityStoreLdapProtocolValues = []string{
+	model.DirectoryLdapServer_PROTOCOL_LDAP,
+	model.DirectoryLdapServer_PROTOCOL_LDAPS,
+}
+
+func resourceNsxtPolicyFirewallIdentityStore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyFirewallIdentityStoreCreate,
+		Read:   resourceNsxtPolicyFirewallIdentityStoreRead,
+		Update: resourceNsxtPolicyFirewallIdentityStoreUpdate,
+		Delete: resourceNsxtPolicyFirewallIdentityStoreDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Fully qualified domain name of Active Directory domain",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"netbios_name": {
+				Type:         schema.TypeString,
+				Description:  "NetBIOS name of the domain",
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 15),
+			},
+			"base_distinguished_name": {
+				Type:        schema.TypeString,
+				Description: "Distinguished name of the domain naming context head",
+				Required:    true,
+			},
+			"ldap_server": {
+				Type:        schema.TypeList,
+				Description: "LDAP servers used to synchronize the domain",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nsx_id": {
+							Type:        schema.TypeString,
+							Description: "NSX ID of the LDAP server",
+							Computed:    true,
+						},
+						"host": {
+							Type:        schema.TypeString,
+							Description: "Host name or IP address of the LDAP server",
+							Required:    true,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Description:  "Port of the LDAP server",
+							Optional:     true,
+							Default:      389,
+							ValidateFunc: validateSinglePort(),
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Description:  "Protocol used to connect to the LDAP server",
+							Optional:     true,
+							Default:      model.DirectoryLdapServer_PROTOCOL_LDAP,
+							ValidateFunc: validation.StringInSlice(firewallIdentityStoreLdapProtocolValues, false),
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Description: "Username for LDAP authentication",
+							Required:    true,
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Description: "Password for LDAP authentication",
+							Required:    true,
+							Sensitive:   true,
+						},
+						"thumbprint": {
+							Type:        schema.TypeString,
+							Description: "SHA-256 thumbprint of the LDAP server certificate, required for LDAPS",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"org_units": {
+				Type:        schema.TypeList,
+				Description: "Distinguished names of organization units to synchronize. If not specified, the whole domain is synchronized",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"sync_settings": {
+				Type:        schema.TypeList,
+				Description: "Synchronization schedule of the domain",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delta_sync_interval": {
+							Type:         schema.TypeInt,
+							Description:  "Interval in minutes between delta synchronizations",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 720),
+						},
+						"full_sync_cron_expr": {
+							Type:        schema.TypeString,
+							Description: "Cron expression for full synchronization schedule",
+							Optional:    true,
+							Computed:    true,
+						},
+						"sync_delay_in_sec": {
+							Type:         schema.TypeInt,
+							Description:  "Delay in seconds before synchronization starts after a change",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 60),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyFirewallIdentityStoreExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewFirewallIdentityStoresClient(connector)
+	_, err := client.Get(id, nil)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Firewall Identity Store", err)
+}
+
+func getFirewallIdentityStoreLdapServersFromSchema(d *schema.ResourceData) []model.DirectoryLdapServer {
+	var servers []model.DirectoryLdapServer
+	for _, item := range d.Get("ldap_server").([]interface{}) {
+		data := item.(map[string]interface{})
+		id := data["nsx_id"].(string)
+		if id == "" {
+			id = newUUID()
+		}
+		host := data["host"].(string)
+		port := int64(data["port"].(int))
+		protocol := data["protocol"].(string)
+		username := data["username"].(string)
+		password := data["password"].(string)
+		server := model.DirectoryLdapServer{
+			Id:       &id,
+			Host:     &host,
+			Port:     &port,
+			Protocol: &protocol,
+			Username: &username,
+			Password: &password,
+		}
+		if thumbprint := data["thumbprint"].(string); thumbprint != "" {
+			server.Thumbprint = &thumbprint
+		}
+		servers = append(servers, server)
+	}
+	return servers
+}
+
+func setFirewallIdentityStoreLdapServersInSchema(d *schema.ResourceData, servers []model.DirectoryLdapServer) error {
+	// Password is not returned by NSX, hence it is preserved from intent
+	passwordMap := make(map[string]string)
+	for _, item := range d.Get("ldap_server").([]interface{}) {
+		data := item.(map[string]interface{})
+		passwordMap[data["host"].(string)] = data["password"].(string)
+	}
+
+	var serverList []map[string]interface{}
+	for _, server := range servers {
+		elem := make(map[string]interface{})
+		elem["nsx_id"] = server.Id
+		elem["host"] = server.Host
+		elem["port"] = server.Port
+		elem["protocol"] = server.Protocol
+		elem["username"] = server.Username
+		elem["thumbprint"] = server.Thumbprint
+		if server.Host != nil {
+			elem["password"] = passwordMap[*server.Host]
+		}
+		serverList = append(serverList, elem)
+	}
+	return d.Set("ldap_server", serverList)
+}
+
+func getFirewallIdentityStoreSyncSettingsFromSchema(d *schema.ResourceData) *model.DirectoryDomainSyncSettings {
+	settings := d.Get("sync_settings").([]interface{})
+	if len(settings) == 0 || settings[0] == nil {
+		return nil
+	}
+	data := settings[0].(map[string]interface{})
+	result := model.DirectoryDomainSyncSettings{}
+	if interval := int64(data["delta_sync_interval"].(int)); interval > 0 {
+		result.DeltaSyncInterval = &interval
+	}
+	if cronExpr := data["full_sync_cron_expr"].(string); cronExpr != "" {
+		result.FullSyncCronExpr = &cronExpr
+	}
+	if delay := int64(data["sync_delay_in_sec"].(int)); delay > 0 {
+		result.SyncDelayInSec = &delay
+	}
+	return &result
+}
+
+func setFirewallIdentityStoreSyncSettingsInSchema(d *schema.ResourceData, settings *model.DirectoryDomainSyncSettings) error {
+	var result []map[string]interface{}
+	if settings != nil {
+		elem := make(map[string]interface{})
+		elem["delta_sync_interval"] = settings.DeltaSyncInterval
+		elem["full_sync_cron_expr"] = settings.FullSyncCronExpr
+		elem["sync_delay_in_sec"] = settings.SyncDelayInSec
+		result = append(result, elem)
+	}
+	return d.Set("sync_settings", result)
+}
+
+func policyFirewallIdentityStorePatch(id string, d *schema.ResourceData, connector client.Connector) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	name := d.Get("name").(string)
+	netbiosName := d.Get("netbios_name").(string)
+	baseDN := d.Get("base_distinguished_name").(string)
+	orgUnits := getStringListFromSchemaList(d, "org_units")
+	selectiveSync := len(orgUnits) > 0
+
+	obj := model.DirectoryAdDomain{
+		DisplayName:           &displayName,
+		Description:           &description,
+		Tags:                  tags,
+		Name:                  &name,
+		NetbiosName:           &netbiosName,
+		BaseDistinguishedName: &baseDN,
+		LdapServers:           getFirewallIdentityStoreLdapServersFromSchema(d),
+		SyncSettings:          getFirewallIdentityStoreSyncSettingsFromSchema(d),
+		SelectiveSyncSettings: &model.SelectiveSyncSettings{
+			Enabled:          &selectiveSync,
+			SelectedOrgUnits: orgUnits,
+		},
+		ResourceType: model.DirectoryDomain_RESOURCE_TYPE_DIRECTORYADDOMAIN,
+	}
+
+	converter := bindings.NewTypeConverter()
+	dataValue, errs := converter.ConvertToVapi(obj, model.DirectoryAdDomainBindingType())
+	if errs != nil {
+		return errs[0]
+	}
+
+	client := infra.NewFirewallIdentityStoresClient(connector)
+	err := client.Patch(id, dataValue.(*data.StructValue), nil)
+	if err != nil {
+		return err
+	}
+
+	// LDAP servers removed from configuration are not deleted by PATCH
+	if d.HasChange("ldap_server") {
+		currentIDs := make(map[string]bool)
+		for _, server := range obj.LdapServers {
+			currentIDs[*server.Id] = true
+		}
+		serverClient := firewall_identity_stores.NewLdapServersClient(connector)
+		oldServers, _ := d.GetChange("ldap_server")
+		for _, oldServer := range oldServers.([]interface{}) {
+			serverID := oldServer.(map[string]interface{})["nsx_id"].(string)
+			if serverID == "" || currentIDs[serverID] {
+				continue
+			}
+			log.Printf("[DEBUG] Deleting LDAP server %s from Firewall Identity Store %s", serverID, id)
+			err = serverClient.Delete(id, serverID, nil)
+			if err != nil && !isNotFoundError(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyFirewallIdentityStoreCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyFirewallIdentityStoreExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Firewall Identity Store with ID %s", id)
+	err = policyFirewallIdentityStorePatch(id, d, connector)
+	if err != nil {
+		return handleCreateError("Firewall Identity Store", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyFirewallIdentityStoreRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallIdentityStoreRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Identity Store ID")
+	}
+
+	client := infra.NewFirewallIdentityStoresClient(connector)
+	structObj, err := client.Get(id, nil)
+	if err != nil {
+		return handleReadError(d, "Firewall Identity Store", id, err)
+	}
+
+	converter := bindings.NewTypeConverter()
+	baseObj, errs := converter.ConvertToGolang(structObj, model.DirectoryAdDomainBindingType())
+	if errs != nil {
+		return errs[0]
+	}
+	obj := baseObj.(model.DirectoryAdDomain)
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	// Directory domain model does not carry policy path
+	d.Set("path", fmt.Sprintf("/infra/firewall-identity-stores/%s", id))
+	d.Set("revision", obj.Revision)
+
+	d.Set("name", obj.Name)
+	d.Set("netbios_name", obj.NetbiosName)
+	d.Set("base_distinguished_name", obj.BaseDistinguishedName)
+	var orgUnits []string
+	if obj.SelectiveSyncSettings != nil && obj.SelectiveSyncSettings.Enabled != nil && *obj.SelectiveSyncSettings.Enabled {
+		orgUnits = obj.SelectiveSyncSettings.SelectedOrgUnits
+	}
+	d.Set("org_units", orgUnits)
+
+	err = setFirewallIdentityStoreSyncSettingsInSchema(d, obj.SyncSettings)
+	if err != nil {
+		return err
+	}
+
+	return setFirewallIdentityStoreLdapServersInSchema(d, obj.LdapServers)
+}
+
+func resourceNsxtPolicyFirewallIdentityStoreUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Identity Store ID")
+	}
+
+	log.Printf("[INFO] Updating Firewall Identity Store with ID %s", id)
+	err := policyFirewallIdentityStorePatch(id, d, connector)
+	if err != nil {
+		return handleUpdateError("Firewall Identity Store", id, err)
+	}
+
+	return resourceNsxtPolicyFirewallIdentityStoreRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallIdentityStoreDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Identity Store ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewFirewallIdentityStoresClient(connector)
+	err := client.Delete(id, nil)
+	if err != nil {
+		return handleDeleteError("Firewall Identity Store", id, err)
+	}
+
+	return nil
+}
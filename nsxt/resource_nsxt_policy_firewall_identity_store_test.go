@@ -0,0 +1,140 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccPreCheckFirewallIdentityStore(t *testing.T) {
+	testAccPreCheck(t)
+	testAccOnlyLocalManager(t)
+	testAccEnvDefined(t, "NSXT_TEST_LDAP_USER")
+	testAccEnvDefined(t, "NSXT_TEST_LDAP_PASSWORD")
+	testAccEnvDefined(t, "NSXT_TEST_LDAP_URL")
+	testAccEnvDefined(t, "NSXT_TEST_LDAP_DOMAIN")
+	testAccEnvDefined(t, "NSXT_TEST_LDAP_BASE_DN")
+	testAccEnvDefined(t, "NSXT_TEST_LDAP_NETBIOS_NAME")
+}
+
+func TestAccResourceNsxtPolicyFirewallIdentityStore_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_firewall_identity_store.test"
+	name := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckFirewallIdentityStore(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallIdentityStoreCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallIdentityStoreTemplate(name, 180),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyFirewallIdentityStoreExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "name", getTestLdapDomain()),
+					resource.TestCheckResourceAttr(testResourceName, "base_distinguished_name", getTestLdapBaseDN()),
+					resource.TestCheckResourceAttr(testResourceName, "ldap_server.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "ldap_server.0.username", getTestLdapUser()),
+					resource.TestCheckResourceAttr(testResourceName, "ldap_server.0.password", getTestLdapPassword()),
+					resource.TestCheckResourceAttrSet(testResourceName, "ldap_server.0.nsx_id"),
+					resource.TestCheckResourceAttr(testResourceName, "sync_settings.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "sync_settings.0.delta_sync_interval", "180"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallIdentityStoreTemplate(name, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyFirewallIdentityStoreExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "sync_settings.0.delta_sync_interval", "60"),
+				),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ldap_server.0.password"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallIdentityStoreExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Firewall Identity Store resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Firewall Identity Store resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyFirewallIdentityStoreExists(resourceID, connector, false)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Firewall Identity Store %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyFirewallIdentityStoreCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_firewall_identity_store" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyFirewallIdentityStoreExists(resourceID, connector, false)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Firewall Identity Store %s still exists", resourceID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyFirewallIdentityStoreTemplate(name string, deltaSyncInterval int) string {
+	host := getTestLdapURL()
+	if ldapURL, err := url.Parse(getTestLdapURL()); err == nil && ldapURL.Hostname() != "" {
+		host = ldapURL.Hostname()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_identity_store" "test" {
+  display_name            = "%s"
+  name                    = "%s"
+  netbios_name            = "%s"
+  base_distinguished_name = "%s"
+
+  ldap_server {
+    host     = "%s"
+    username = "%s"
+    password = "%s"
+  }
+
+  sync_settings {
+    delta_sync_interval = %d
+  }
+}`, name, getTestLdapDomain(), getTestLdapNetbiosName(), getTestLdapBaseDN(), host, getTestLdapUser(), getTestLdapPassword(), deltaSyncInterval)
+}
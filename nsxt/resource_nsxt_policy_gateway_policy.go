@@ -67,16 +67,21 @@ func getUpdatedRuleChildren(d *schema.ResourceData) ([]*data.StructValue, error)
 	}
 
 	oldRules, newRules := d.GetChange("rule")
-	rules := getPolicyRulesFromSchema(d)
+	rules, err := getPolicyRulesFromSchema(d)
+	if err != nil {
+		return nil, err
+	}
+	if isPolicyRuleAnchorUsed(d) {
+		if err := setPolicyRuleIDsInSchema(d, rules); err != nil {
+			return nil, err
+		}
+	}
 	newRulesCount := len(newRules.([]interface{}))
 	oldRulesCount := len(oldRules.([]interface{}))
 	for ruleNo := 0; ruleNo < newRulesCount; ruleNo++ {
 		ruleIndicator := fmt.Sprintf("rule.%d", ruleNo)
-		autoAssignedSequence := false
 		originalSequence := d.Get(fmt.Sprintf("%s.sequence_number", ruleIndicator)).(int)
-		if originalSequence == 0 {
-			autoAssignedSequence = true
-		}
+		autoAssignedSequence := int64(originalSequence) != *rules[ruleNo].SequenceNumber
 		if d.HasChange(ruleIndicator) || autoAssignedSequence {
 			// If the provider assigned sequence number to this rule, we need to update it even
 			// though terraform sees no diff
@@ -0,0 +1,47 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyGatewayRouteAggregationResource = policyGatewayPrefixEntryResource[model.RouteAggregationEntry]{
+	name:              "Route Aggregation",
+	prefixDescription: "CIDR of aggregate address",
+	flagAttribute:     "summary_only",
+	flagDescription:   "Send only summarized route",
+	readEntries: func(connector client.Connector, gwID string, localeServiceID string) ([]model.RouteAggregationEntry, *string, error) {
+		obj, err := locale_services.NewBgpClient(connector).Get(gwID, localeServiceID)
+		return obj.RouteAggregations, obj.Path, err
+	},
+	updateEntries: func(connector client.Connector, gwID string, localeServiceID string, modify func([]model.RouteAggregationEntry) ([]model.RouteAggregationEntry, error)) error {
+		client := locale_services.NewBgpClient(connector)
+		obj, err := client.Get(gwID, localeServiceID)
+		if err != nil {
+			return err
+		}
+		obj.RouteAggregations, err = modify(obj.RouteAggregations)
+		if err != nil {
+			return err
+		}
+		_, err = client.Update(gwID, localeServiceID, obj, nil)
+		return err
+	},
+	getEntryPrefix: func(entry model.RouteAggregationEntry) *string { return entry.Prefix },
+	getEntryFlag:   func(entry model.RouteAggregationEntry) *bool { return entry.SummaryOnly },
+	newEntry: func(prefix string, summaryOnly bool) model.RouteAggregationEntry {
+		return model.RouteAggregationEntry{
+			Prefix:      &prefix,
+			SummaryOnly: &summaryOnly,
+		}
+	},
+}
+
+func resourceNsxtPolicyGatewayRouteAggregation() *schema.Resource {
+	return policyGatewayRouteAggregationResource.resource()
+}
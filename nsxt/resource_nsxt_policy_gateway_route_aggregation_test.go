@@ -0,0 +1,119 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services"
+)
+
+func TestAccResourceNsxtPolicyGatewayRouteAggregation_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_gateway_route_aggregation.test"
+	otherResourceName := "nsxt_policy_gateway_route_aggregation.other"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGatewayRouteAggregationCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayRouteAggregationTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGatewayRouteAggregationExists(testResourceName),
+					testAccNsxtPolicyGatewayRouteAggregationExists(otherResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "prefix", "20.1.0.0/16"),
+					resource.TestCheckResourceAttr(testResourceName, "summary_only", "true"),
+					resource.TestCheckResourceAttr(otherResourceName, "prefix", "20.2.0.0/16"),
+					resource.TestCheckResourceAttrSet(testResourceName, "gateway_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "locale_service_id"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyGatewayRouteAggregationTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGatewayRouteAggregationExists(testResourceName),
+					testAccNsxtPolicyGatewayRouteAggregationExists(otherResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "summary_only", "false"),
+					resource.TestCheckResourceAttr(otherResourceName, "summary_only", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGatewayRouteAggregationExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Route Aggregation resource %s not found in resources", resourceName)
+		}
+
+		gwID := rs.Primary.Attributes["gateway_id"]
+		localeServiceID := rs.Primary.Attributes["locale_service_id"]
+		prefix := rs.Primary.Attributes["prefix"]
+		obj, err := locale_services.NewBgpClient(connector).Get(gwID, localeServiceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving BGP config for gateway %s: %v", gwID, err)
+		}
+		for _, entry := range obj.RouteAggregations {
+			if entry.Prefix != nil && *entry.Prefix == prefix {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Policy Route Aggregation %s does not exist", prefix)
+	}
+}
+
+func testAccNsxtPolicyGatewayRouteAggregationCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_gateway_route_aggregation" {
+			continue
+		}
+
+		gwID := rs.Primary.Attributes["gateway_id"]
+		localeServiceID := rs.Primary.Attributes["locale_service_id"]
+		prefix := rs.Primary.Attributes["prefix"]
+		obj, err := locale_services.NewBgpClient(connector).Get(gwID, localeServiceID)
+		if err != nil {
+			// Gateway is gone as well
+			continue
+		}
+		for _, entry := range obj.RouteAggregations {
+			if entry.Prefix != nil && *entry.Prefix == prefix {
+				return fmt.Errorf("Policy Route Aggregation %s still exists", prefix)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyGatewayRouteAggregationTemplate(summaryOnly bool) string {
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+		testAccNsxtPolicyTier0WithEdgeClusterTemplate("test", false) + fmt.Sprintf(`
+resource "nsxt_policy_gateway_route_aggregation" "test" {
+  gateway_path = nsxt_policy_tier0_gateway.test.path
+  prefix       = "20.1.0.0/16"
+  summary_only = %t
+}
+
+resource "nsxt_policy_gateway_route_aggregation" "other" {
+  gateway_path = nsxt_policy_tier0_gateway.test.path
+  prefix       = "20.2.0.0/16"
+  depends_on   = [nsxt_policy_gateway_route_aggregation.test]
+}`, summaryOnly)
+}
@@ -4,30 +4,65 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/fabric"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/fabric/compute_collections"
+	mpModel "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/sites/enforcement_points"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/sites/enforcement_points/host_transport_nodes"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"golang.org/x/exp/slices"
 )
 
 const removeOnDestroyDefault = true
 
+const (
+	hostRolloutFailurePolicyStop     = "STOP"
+	hostRolloutFailurePolicyContinue = "CONTINUE"
+
+	hostRolloutMaintenanceModeIgnore = "IGNORE"
+	hostRolloutMaintenanceModeSkip   = "SKIP"
+	hostRolloutMaintenanceModeExit   = "EXIT"
+
+	hostRolloutStateSkipped = "skipped"
+
+	// Origin property of discovered node, reported by compute manager for hosts that
+	// are not transport nodes yet
+	discoveredNodeMaintenanceModeProperty = "inMaintenanceMode"
+)
+
+var hostRolloutFailurePolicyValues = []string{
+	hostRolloutFailurePolicyStop,
+	hostRolloutFailurePolicyContinue,
+}
+
+var hostRolloutMaintenanceModeValues = []string{
+	hostRolloutMaintenanceModeIgnore,
+	hostRolloutMaintenanceModeSkip,
+	hostRolloutMaintenanceModeExit,
+}
+
 func resourceNsxtPolicyHostTransportNodeCollection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyHostTransportNodeCollectionCreate,
-		Read:   resourceNsxtPolicyHostTransportNodeCollectionRead,
-		Update: resourceNsxtPolicyHostTransportNodeCollectionUpdate,
-		Delete: resourceNsxtPolicyHostTransportNodeCollectionDelete,
+		CreateContext: resourceNsxtPolicyHostTransportNodeCollectionCreate,
+		Read:          resourceNsxtPolicyHostTransportNodeCollectionRead,
+		UpdateContext: resourceNsxtPolicyHostTransportNodeCollectionUpdate,
+		Delete:        resourceNsxtPolicyHostTransportNodeCollectionDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyHostTransportNodeCollectionImporter,
 		},
+		CustomizeDiff: resourceNsxtPolicyHostTransportNodeCollectionCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":       getNsxIDSchema(),
@@ -100,10 +135,106 @@ func resourceNsxtPolicyHostTransportNodeCollection() *schema.Resource {
 				Description: "Indicate whether NSX service should be removed from hypervisors during resource deletion",
 				Default:     removeOnDestroyDefault,
 			},
+			"rollout": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Apply transport node profile to hosts of the compute collection in batches",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"batch_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							Description:  "Number of hosts configured in parallel",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"failure_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      hostRolloutFailurePolicyStop,
+							Description:  "Whether to stop the rollout after a batch with failures, or continue with next batches",
+							ValidateFunc: validation.StringInSlice(hostRolloutFailurePolicyValues, false),
+						},
+						"maintenance_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      hostRolloutMaintenanceModeIgnore,
+							Description:  "Handling of hosts in maintenance mode",
+							ValidateFunc: validation.StringInSlice(hostRolloutMaintenanceModeValues, false),
+						},
+						"timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1200,
+							Description:  "Realization timeout for a batch in seconds",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"host_realization": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Realization status of hosts configured by rollout",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"discovered_node_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Discovered node ID of the host",
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Display name of the host",
+						},
+						"transport_node_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Transport node ID of the host",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Realization state of the host transport node",
+						},
+						"maintenance_mode_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Maintenance mode state of the host transport node",
+						},
+						"pending_user_action": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Actions user needs to perform to complete the realization",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"failure_message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Error message in case of failure",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// Plan an update when previous rollout did not configure all hosts, so that rollout is retried
+func resourceNsxtPolicyHostTransportNodeCollectionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || len(d.Get("rollout").([]interface{})) == 0 {
+		return nil
+	}
+	if isHostRolloutIncomplete(d.Get("host_realization").([]interface{})) {
+		return d.SetNewComputed("host_realization")
+	}
+	return nil
+}
+
 func resourceNsxtPolicyHostTransportNodeCollectionExists(siteID, epID, id string, connector client.Connector) (bool, error) {
 	// Check site existence first
 	siteClient := infra.NewSitesClient(connector)
@@ -127,6 +258,8 @@ func resourceNsxtPolicyHostTransportNodeCollectionExists(siteID, epID, id string
 }
 
 func policyHostTransportNodeCollectionUpdate(siteID, epID, id string, isCreate bool, d *schema.ResourceData, m interface{}) error {
+	// With rollout, profile is applied to hosts in batches rather than to the whole collection at once
+	applyProfile := isCreate && len(d.Get("rollout").([]interface{})) == 0
 	connector := getPolicyConnector(m)
 
 	displayName := d.Get("display_name").(string)
@@ -170,12 +303,12 @@ func policyHostTransportNodeCollectionUpdate(siteID, epID, id string, isCreate b
 		obj.Revision = &revision
 	}
 	client := enforcement_points.NewTransportNodeCollectionsClient(connector)
-	_, err := client.Update(siteID, epID, id, obj, &isCreate, nil)
+	_, err := client.Update(siteID, epID, id, obj, &applyProfile, nil)
 
 	return err
 }
 
-func resourceNsxtPolicyHostTransportNodeCollectionCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyHostTransportNodeCollectionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Get("nsx_id").(string)
 	if id == "" {
@@ -184,7 +317,7 @@ func resourceNsxtPolicyHostTransportNodeCollectionCreate(d *schema.ResourceData,
 	sitePath := d.Get("site_path").(string)
 	siteID := getResourceIDFromResourcePath(sitePath, "sites")
 	if siteID == "" {
-		return fmt.Errorf("error obtaining Site ID from site path %s", sitePath)
+		return diag.Errorf("error obtaining Site ID from site path %s", sitePath)
 	}
 	epID := d.Get("enforcement_point").(string)
 	if epID == "" {
@@ -193,23 +326,34 @@ func resourceNsxtPolicyHostTransportNodeCollectionCreate(d *schema.ResourceData,
 
 	exists, err := resourceNsxtPolicyHostTransportNodeCollectionExists(siteID, epID, id, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if exists {
-		return fmt.Errorf("resource with ID %s already exists", id)
+		return diag.Errorf("resource with ID %s already exists", id)
 	}
 
 	// Create the resource using PATCH
 	log.Printf("[INFO] Creating HostTransportNodeCollection with ID %s under site %s enforcement point %s", id, siteID, epID)
 	err = policyHostTransportNodeCollectionUpdate(siteID, epID, id, true, d, m)
 	if err != nil {
-		return handleCreateError("HostTransportNodeCollection", id, err)
+		return diag.FromErr(handleCreateError("HostTransportNodeCollection", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyHostTransportNodeCollectionRead(d, m)
+	var diags diag.Diagnostics
+	if len(d.Get("rollout").([]interface{})) > 0 {
+		// The collection exists at this point, hence it is kept in state along with host_realization
+		// when rollout is stopped. Terraform marks it as tainted.
+		failedHosts, err := rolloutHostTransportNodeCollection(siteID, epID, true, d, m)
+		if err != nil {
+			return diag.FromErr(handleCreateError("HostTransportNodeCollection", id, err))
+		}
+		diags = getHostRolloutDiagnostics(id, failedHosts)
+	}
+
+	return append(diags, diag.FromErr(resourceNsxtPolicyHostTransportNodeCollectionRead(d, m))...)
 }
 
 func resourceNsxtPolicyHostTransportNodeCollectionRead(d *schema.ResourceData, m interface{}) error {
@@ -255,23 +399,332 @@ func resourceNsxtPolicyHostTransportNodeCollectionRead(d *schema.ResourceData, m
 		d.Set("sub_cluster_config", sccList)
 	}
 	d.Set("transport_node_profile_path", obj.TransportNodeProfileId)
+
+	if len(d.Get("host_realization").([]interface{})) > 0 {
+		err = refreshHostTransportNodeCollectionRealization(connector, siteID, epID, d)
+		if err != nil {
+			return handleReadError(d, "HostTransportNodeCollection", id, err)
+		}
+	}
 	return nil
 }
 
-func resourceNsxtPolicyHostTransportNodeCollectionUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyHostTransportNodeCollectionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id, siteID, epID, err := policyIDSiteEPTuple(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Updating HostTransportNodeCollection with ID %s", id)
 	err = policyHostTransportNodeCollectionUpdate(siteID, epID, id, false, d, m)
 
 	if err != nil {
-		return handleUpdateError("HostTransportNodeCollection", id, err)
+		return diag.FromErr(handleUpdateError("HostTransportNodeCollection", id, err))
+	}
+
+	var diags diag.Diagnostics
+	if len(d.Get("rollout").([]interface{})) > 0 {
+		// Profile is reapplied to all hosts only when it changes, otherwise rollout is retried
+		// for hosts that failed in previous rollout
+		reapplyAll := d.HasChanges("transport_node_profile_path", "sub_cluster_config")
+		previous, _ := d.GetChange("host_realization")
+		if reapplyAll || isHostRolloutIncomplete(previous.([]interface{})) {
+			failedHosts, err := rolloutHostTransportNodeCollection(siteID, epID, reapplyAll, d, m)
+			if err != nil {
+				return diag.FromErr(handleUpdateError("HostTransportNodeCollection", id, err))
+			}
+			diags = getHostRolloutDiagnostics(id, failedHosts)
+		}
+	}
+
+	return append(diags, diag.FromErr(resourceNsxtPolicyHostTransportNodeCollectionRead(d, m))...)
+}
+
+// Report hosts that failed rollout with CONTINUE failure policy as a warning
+func getHostRolloutDiagnostics(id string, failedHosts []string) diag.Diagnostics {
+	if len(failedHosts) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Rollout of HostTransportNodeCollection %s completed with failures on %d hosts, rollout will be retried for these hosts on next apply", id, len(failedHosts)),
+		Detail:   strings.Join(failedHosts, "\n"),
+	}}
+}
+
+// Refresh state of hosts configured by rollout
+func refreshHostTransportNodeCollectionRealization(connector client.Connector, siteID, epID string, d *schema.ResourceData) error {
+	stateClient := host_transport_nodes.NewStateClient(connector)
+	stateList, err := stateClient.List(siteID, epID, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	states := make(map[string]model.TransportNodeState)
+	for _, state := range stateList.Results {
+		if state.TransportNodeId != nil {
+			states[*state.TransportNodeId] = state
+		}
+	}
+
+	var hostList []map[string]interface{}
+	for _, hostI := range d.Get("host_realization").([]interface{}) {
+		host := hostI.(map[string]interface{})
+		if state, ok := states[host["transport_node_id"].(string)]; ok {
+			setHostRealizationFromState(host, &state)
+		}
+		hostList = append(hostList, host)
+	}
+	return d.Set("host_realization", hostList)
+}
+
+func setHostRealizationFromState(host map[string]interface{}, state *model.TransportNodeState) {
+	host["state"] = ""
+	if state.State != nil {
+		host["state"] = *state.State
+	}
+	host["maintenance_mode_state"] = ""
+	if state.MaintenanceModeState != nil {
+		host["maintenance_mode_state"] = *state.MaintenanceModeState
+	}
+	host["pending_user_action"] = stringList2Interface(state.PendingUserActions)
+	host["failure_message"] = ""
+	if state.FailureMessage != nil {
+		host["failure_message"] = *state.FailureMessage
+	}
+}
+
+// Check whether host is in maintenance mode. For hosts that are not transport nodes yet,
+// maintenance mode is reported by compute manager in discovered node origin properties.
+func isHostInMaintenanceMode(connector client.Connector, siteID, epID string, node mpModel.DiscoveredNode) (bool, error) {
+	for _, property := range node.OriginProperties {
+		if property.Key != nil && *property.Key == discoveredNodeMaintenanceModeProperty && property.Value != nil {
+			return strings.EqualFold(*property.Value, "true"), nil
+		}
+	}
+
+	discoveredNodeID := *node.ExternalId
+	client := enforcement_points.NewHostTransportNodesClient(connector)
+	inMaintenanceMode := true
+	tnList, err := client.List(siteID, epID, nil, &discoveredNodeID, &inMaintenanceMode, nil, nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		return false, err
+	}
+	return len(tnList.Results) > 0, nil
+}
+
+// Wait for realization of transport nodes of a batch. Host is considered realized when its state is
+// no longer pending, or when user action is required to complete the realization.
+func waitForHostTransportNodesRealization(connector client.Connector, siteID, epID string, hosts []map[string]interface{}, timeout int) error {
+	stateClient := host_transport_nodes.NewStateClient(connector)
+	pendingStates := []string{
+		model.TransportNodeState_STATE_PENDING,
+		model.TransportNodeState_STATE_IN_PROGRESS,
+		model.TransportNodeState_STATE_IN_SYNC,
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"notyet"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			for _, host := range hosts {
+				tnID := host["transport_node_id"].(string)
+				currentState := host["state"].(string)
+				if tnID == "" || (currentState != "" && !slices.Contains(pendingStates, currentState)) {
+					continue
+				}
+				state, err := stateClient.Get(siteID, epID, tnID)
+				if err != nil {
+					if isNotFoundError(err) {
+						// Transport node state might not be available yet
+						return "notyet", "notyet", nil
+					}
+					return nil, "failed", logAPIError("Error getting transport node state", err)
+				}
+				setHostRealizationFromState(host, &state)
+				log.Printf("[DEBUG] Current realization state for Transport Node %s is %s", tnID, host["state"])
+				if slices.Contains(pendingStates, host["state"].(string)) && len(state.PendingUserActions) == 0 {
+					return "notyet", "notyet", nil
+				}
+			}
+			return "done", "done", nil
+		},
+		Delay:        time.Duration(5) * time.Second,
+		Timeout:      time.Duration(timeout) * time.Second,
+		PollInterval: time.Duration(5) * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+// Check whether previous rollout left hosts that were not configured successfully
+func isHostRolloutIncomplete(hosts []interface{}) bool {
+	for _, hostI := range hosts {
+		host := hostI.(map[string]interface{})
+		state := host["state"].(string)
+		if state != model.TransportNodeState_STATE_SUCCESS && state != hostRolloutStateSkipped {
+			return true
+		}
+	}
+	return false
+}
+
+func listComputeCollectionHosts(connector client.Connector, computeCollectionID string) ([]mpModel.DiscoveredNode, error) {
+	nodeType := "HostNode"
+	discoveredNodeClient := fabric.NewDiscoveredNodesClient(connector)
+	var nodes []mpModel.DiscoveredNode
+	var cursor *string
+	for {
+		nodeList, err := discoveredNodeClient.List(nil, cursor, nil, nil, nil, nil, nil, nil, &nodeType, nil, nil, &computeCollectionID, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, nodeList.Results...)
+		if nodeList.Cursor == nil || *nodeList.Cursor == "" || len(nodeList.Results) == 0 {
+			return nodes, nil
+		}
+		cursor = nodeList.Cursor
+	}
+}
+
+// Build realization list of compute collection hosts, and return indexes of hosts the profile
+// should be applied to. Unless reapplyAll is set, hosts configured successfully by previous
+// rollout keep their state and are not configured again.
+func getHostRolloutList(nodes []mpModel.DiscoveredNode, previous []interface{}, reapplyAll bool) ([]map[string]interface{}, []int) {
+	previousHosts := make(map[string]map[string]interface{})
+	for _, hostI := range previous {
+		host := hostI.(map[string]interface{})
+		previousHosts[host["discovered_node_id"].(string)] = host
+	}
+
+	var hostList []map[string]interface{}
+	var pending []int
+	for _, node := range nodes {
+		if node.ExternalId == nil {
+			log.Printf("[WARN] Skipping discovered node without external ID")
+			continue
+		}
+		prevHost, ok := previousHosts[*node.ExternalId]
+		if ok && !reapplyAll && prevHost["state"].(string) == model.TransportNodeState_STATE_SUCCESS {
+			hostList = append(hostList, prevHost)
+			continue
+		}
+
+		host := make(map[string]interface{})
+		host["discovered_node_id"] = *node.ExternalId
+		host["display_name"] = ""
+		if node.DisplayName != nil {
+			host["display_name"] = *node.DisplayName
+		}
+		host["transport_node_id"] = ""
+		host["state"] = ""
+		host["maintenance_mode_state"] = ""
+		host["pending_user_action"] = []interface{}{}
+		host["failure_message"] = ""
+		pending = append(pending, len(hostList))
+		hostList = append(hostList, host)
+	}
+	return hostList, pending
+}
+
+// Apply transport node profile of the collection to its hosts in batches.
+// Hosts that failed are returned when rollout continued after failures.
+func rolloutHostTransportNodeCollection(siteID, epID string, reapplyAll bool, d *schema.ResourceData, m interface{}) ([]string, error) {
+	connector := getPolicyConnector(m)
+	rollout := d.Get("rollout").([]interface{})[0].(map[string]interface{})
+	batchSize := rollout["batch_size"].(int)
+	failurePolicy := rollout["failure_policy"].(string)
+	maintenanceMode := rollout["maintenance_mode"].(string)
+	timeout := rollout["timeout"].(int)
+
+	computeCollectionID := d.Get("compute_collection_id").(string)
+	nodes, err := listComputeCollectionHosts(connector, computeCollectionID)
+	if err != nil {
+		return nil, logAPIError("Error listing hosts of compute collection", err)
+	}
+	nodesByID := make(map[string]mpModel.DiscoveredNode)
+	for _, node := range nodes {
+		if node.ExternalId != nil {
+			nodesByID[*node.ExternalId] = node
+		}
+	}
+
+	previous, _ := d.GetChange("host_realization")
+	hostList, pending := getHostRolloutList(nodes, previous.([]interface{}), reapplyAll)
+	// Record hosts before configuring them, so that hosts not reached by a failed rollout
+	// are retried upon next apply
+	d.Set("host_realization", hostList)
+
+	discoveredNodeClient := fabric.NewDiscoveredNodesClient(connector)
+	tnClient := enforcement_points.NewHostTransportNodesClient(connector)
+	var failedHosts []string
+	for start := 0; start < len(pending); start += batchSize {
+		end := start + batchSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+		var batch []map[string]interface{}
+		for _, i := range pending[start:end] {
+			batch = append(batch, hostList[i])
+		}
+
+		for _, host := range batch {
+			discoveredNodeID := host["discovered_node_id"].(string)
+			if maintenanceMode == hostRolloutMaintenanceModeSkip {
+				inMaintenanceMode, err := isHostInMaintenanceMode(connector, siteID, epID, nodesByID[discoveredNodeID])
+				if err != nil {
+					return nil, logAPIError("Error retrieving host maintenance mode", err)
+				}
+				if inMaintenanceMode {
+					log.Printf("[INFO] Skipping host %s which is in maintenance mode", discoveredNodeID)
+					host["state"] = hostRolloutStateSkipped
+					continue
+				}
+			}
+			log.Printf("[INFO] Applying cluster configuration on host %s", discoveredNodeID)
+			tn, err := discoveredNodeClient.Reapplyclusterconfig(discoveredNodeID, nil)
+			if err != nil {
+				host["state"] = model.TransportNodeState_STATE_FAILED
+				host["failure_message"] = fmt.Sprintf("%v", logAPIError("Error applying cluster configuration", err))
+				continue
+			}
+			if tn.Id != nil {
+				host["transport_node_id"] = *tn.Id
+			}
+		}
+
+		err = waitForHostTransportNodesRealization(connector, siteID, epID, batch, timeout)
+		if err != nil {
+			d.Set("host_realization", hostList)
+			return nil, fmt.Errorf("failed to wait for realization of hosts %d-%d: %v", start+1, end, err)
+		}
+
+		var batchFailures []string
+		for _, host := range batch {
+			state := host["state"].(string)
+			if state == model.TransportNodeState_STATE_SUCCESS {
+				if maintenanceMode == hostRolloutMaintenanceModeExit && host["maintenance_mode_state"].(string) != model.TransportNodeState_MAINTENANCE_MODE_STATE_DISABLED {
+					action := enforcement_points.HostTransportNodes_UPDATEMAINTENANCEMODE_ACTION_EXIT_MAINTENANCE_MODE
+					log.Printf("[INFO] Exiting maintenance mode on host %s", host["discovered_node_id"])
+					err = tnClient.Updatemaintenancemode(siteID, epID, host["transport_node_id"].(string), &action)
+					if err != nil {
+						batchFailures = append(batchFailures, fmt.Sprintf("%s: failed to exit maintenance mode: %v", host["display_name"], err))
+					}
+				}
+				continue
+			}
+			if state == hostRolloutStateSkipped {
+				continue
+			}
+			batchFailures = append(batchFailures, fmt.Sprintf("%s: state %s, pending user actions %v %s", host["display_name"], state, host["pending_user_action"], host["failure_message"]))
+		}
+		failedHosts = append(failedHosts, batchFailures...)
+		d.Set("host_realization", hostList)
+
+		if len(batchFailures) > 0 && failurePolicy == hostRolloutFailurePolicyStop {
+			return nil, fmt.Errorf("rollout stopped after failures on hosts:\n%s", strings.Join(batchFailures, "\n"))
+		}
 	}
 
-	return resourceNsxtPolicyHostTransportNodeCollectionRead(d, m)
+	return failedHosts, nil
 }
 
 func getComputeCollectionMemberStateConf(connector client.Connector, id string) *resource.StateChangeConf {
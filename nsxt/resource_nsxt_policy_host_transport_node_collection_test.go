@@ -0,0 +1,138 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	mpModel "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func testHostRealization(id string, state string) map[string]interface{} {
+	return map[string]interface{}{
+		"discovered_node_id":     id,
+		"display_name":           id,
+		"transport_node_id":      "tn-" + id,
+		"state":                  state,
+		"maintenance_mode_state": "",
+		"pending_user_action":    []interface{}{},
+		"failure_message":        "",
+	}
+}
+
+func testDiscoveredNode(id string, properties map[string]string) mpModel.DiscoveredNode {
+	node := mpModel.DiscoveredNode{DisplayName: &id}
+	if id != "" {
+		node.ExternalId = &id
+	}
+	for key, value := range properties {
+		k := key
+		v := value
+		node.OriginProperties = append(node.OriginProperties, mpModel.KeyValuePair{Key: &k, Value: &v})
+	}
+	return node
+}
+
+func TestIsHostRolloutIncomplete(t *testing.T) {
+	cases := []struct {
+		states     []string
+		incomplete bool
+	}{
+		{nil, false},
+		{[]string{model.TransportNodeState_STATE_SUCCESS, hostRolloutStateSkipped}, false},
+		{[]string{model.TransportNodeState_STATE_SUCCESS, model.TransportNodeState_STATE_FAILED}, true},
+		{[]string{model.TransportNodeState_STATE_SUCCESS, ""}, true},
+	}
+	for _, c := range cases {
+		var hosts []interface{}
+		for i, state := range c.states {
+			hosts = append(hosts, testHostRealization(string(rune('a'+i)), state))
+		}
+		if result := isHostRolloutIncomplete(hosts); result != c.incomplete {
+			t.Errorf("Hosts in states %v: expected incomplete %v, got %v", c.states, c.incomplete, result)
+		}
+	}
+}
+
+func TestGetHostRolloutList(t *testing.T) {
+	nodes := []mpModel.DiscoveredNode{
+		testDiscoveredNode("h1", nil),
+		testDiscoveredNode("", nil),
+		testDiscoveredNode("h2", nil),
+		testDiscoveredNode("h3", nil),
+		testDiscoveredNode("h4", nil),
+	}
+	previous := []interface{}{
+		testHostRealization("h1", model.TransportNodeState_STATE_SUCCESS),
+		testHostRealization("h2", model.TransportNodeState_STATE_FAILED),
+		testHostRealization("h3", hostRolloutStateSkipped),
+	}
+
+	hostList, pending := getHostRolloutList(nodes, previous, false)
+	if len(hostList) != 4 {
+		t.Fatalf("Expected node without external ID to be ignored, got %v", hostList)
+	}
+	if hostList[0]["state"] != model.TransportNodeState_STATE_SUCCESS || hostList[0]["transport_node_id"] != "tn-h1" {
+		t.Errorf("Expected successful host to keep its state, got %v", hostList[0])
+	}
+	if len(pending) != 3 || pending[0] != 1 || pending[1] != 2 || pending[2] != 3 {
+		t.Errorf("Expected failed, skipped and new hosts to be pending, got %v", pending)
+	}
+	if hostList[1]["state"] != "" {
+		t.Errorf("Expected state of pending host to be reset, got %v", hostList[1])
+	}
+
+	_, pending = getHostRolloutList(nodes, previous, true)
+	if len(pending) != 4 {
+		t.Errorf("Expected all hosts to be pending when profile is reapplied, got %v", pending)
+	}
+}
+
+func TestIsHostInMaintenanceModeFromOrigin(t *testing.T) {
+	for value, expected := range map[string]bool{"true": true, "True": true, "false": false} {
+		node := testDiscoveredNode("h1", map[string]string{discoveredNodeMaintenanceModeProperty: value})
+		// Origin property is used without querying NSX, hence no connector is needed
+		result, err := isHostInMaintenanceMode(nil, "default", "default", node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result != expected {
+			t.Errorf("Origin property %s: expected maintenance mode %v, got %v", value, expected, result)
+		}
+	}
+}
+
+func TestHostTransportNodeCollectionRolloutRetryDiff(t *testing.T) {
+	res := resourceNsxtPolicyHostTransportNodeCollection()
+	config := map[string]interface{}{
+		"display_name":                "htnc",
+		"compute_collection_id":       "cc1",
+		"transport_node_profile_path": "/infra/host-transport-node-profiles/tnp1",
+		"rollout":                     []interface{}{map[string]interface{}{"batch_size": 2}},
+	}
+
+	for _, c := range []struct {
+		state   string
+		updated bool
+	}{
+		{model.TransportNodeState_STATE_SUCCESS, false},
+		{model.TransportNodeState_STATE_FAILED, true},
+	} {
+		d := schema.TestResourceDataRaw(t, res.Schema, config)
+		d.SetId("htnc")
+		d.Set("host_realization", []interface{}{testHostRealization("h1", c.state)})
+		diff, err := res.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		updated := diff != nil && len(diff.Attributes) > 0
+		if updated != c.updated {
+			t.Errorf("Host in state %s: expected update %v, got %v", c.state, c.updated, diff)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/idfw"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyIdfwCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIdfwClusterCreate,
+		Read:   resourceNsxtPolicyIdfwClusterRead,
+		Update: resourceNsxtPolicyIdfwClusterUpdate,
+		Delete: resourceNsxtPolicyIdfwClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyIdfwClusterImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"compute_collection_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the compute collection representing the cluster",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether identity firewall is enabled on the cluster",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyIdfwClusterExists(id string, connector client.Connector) (bool, error) {
+	client := idfw.NewClusterClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving IDFW Cluster configuration", err)
+}
+
+func policyIdfwClusterPatch(id string, d *schema.ResourceData, connector client.Connector) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	enabled := d.Get("enabled").(bool)
+
+	obj := model.ComputeClusterIdfwConfiguration{
+		DisplayName:        &displayName,
+		Description:        &description,
+		Tags:               tags,
+		ClusterIdfwEnabled: &enabled,
+		Member: &model.PolicyResourceReference{
+			TargetId: &id,
+		},
+	}
+
+	client := idfw.NewClusterClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyIdfwClusterCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+
+	// IDFW configuration is keyed by compute collection
+	id := d.Get("compute_collection_id").(string)
+	exists, err := resourceNsxtPolicyIdfwClusterExists(id, connector)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("IDFW configuration for cluster %s already exists", id)
+	}
+
+	log.Printf("[INFO] Creating IDFW Cluster configuration with ID %s", id)
+	err = policyIdfwClusterPatch(id, d, connector)
+	if err != nil {
+		return handleCreateError("IDFW Cluster configuration", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceNsxtPolicyIdfwClusterRead(d, m)
+}
+
+func resourceNsxtPolicyIdfwClusterRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IDFW Cluster configuration ID")
+	}
+
+	client := idfw.NewClusterClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "IDFW Cluster configuration", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("compute_collection_id", id)
+	d.Set("enabled", obj.ClusterIdfwEnabled)
+
+	return nil
+}
+
+func resourceNsxtPolicyIdfwClusterUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IDFW Cluster configuration ID")
+	}
+
+	log.Printf("[INFO] Updating IDFW Cluster configuration with ID %s", id)
+	err := policyIdfwClusterPatch(id, d, connector)
+	if err != nil {
+		return handleUpdateError("IDFW Cluster configuration", id, err)
+	}
+
+	return resourceNsxtPolicyIdfwClusterRead(d, m)
+}
+
+func resourceNsxtPolicyIdfwClusterDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IDFW Cluster configuration ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := idfw.NewClusterClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("IDFW Cluster configuration", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyIdfwClusterImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	if isSpaceString(importID) {
+		return []*schema.ResourceData{d}, ErrEmptyImportID
+	}
+	d.Set("compute_collection_id", importID)
+	return []*schema.ResourceData{d}, nil
+}
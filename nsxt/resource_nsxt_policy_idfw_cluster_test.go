@@ -0,0 +1,59 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceNsxtPolicyIdfwCluster_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_idfw_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_COMPUTE_COLLECTION")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIdfwClusterTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testResourceName, "compute_collection_id", "data.nsxt_compute_collection.test", "id"),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIdfwClusterTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIdfwClusterTemplate(enabled bool) string {
+	return fmt.Sprintf(`
+data "nsxt_compute_collection" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_idfw_cluster" "test" {
+  display_name          = "%s"
+  compute_collection_id = data.nsxt_compute_collection.test.id
+  enabled               = %t
+}`, getComputeCollectionName(), getComputeCollectionName(), enabled)
+}
@@ -0,0 +1,196 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyIgmpProfileCreateAttributes = map[string]string{
+	"display_name":               getAccTestResourceName(),
+	"description":                "terraform created",
+	"last_member_query_interval": "5",
+	"query_interval":             "60",
+	"query_max_response_time":    "12",
+	"robustness_variable":        "3",
+}
+
+var accTestPolicyIgmpProfileUpdateAttributes = map[string]string{
+	"display_name":               getAccTestResourceName(),
+	"description":                "terraform updated",
+	"last_member_query_interval": "7",
+	"query_interval":             "90",
+	"query_max_response_time":    "20",
+	"robustness_variable":        "4",
+}
+
+func TestAccResourceNsxtPolicyIgmpProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_igmp_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIgmpProfileCheckDestroy(state, accTestPolicyIgmpProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIgmpProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIgmpProfileExists(accTestPolicyIgmpProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyIgmpProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyIgmpProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "last_member_query_interval", accTestPolicyIgmpProfileCreateAttributes["last_member_query_interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "query_interval", accTestPolicyIgmpProfileCreateAttributes["query_interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "query_max_response_time", accTestPolicyIgmpProfileCreateAttributes["query_max_response_time"]),
+					resource.TestCheckResourceAttr(testResourceName, "robustness_variable", accTestPolicyIgmpProfileCreateAttributes["robustness_variable"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIgmpProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIgmpProfileExists(accTestPolicyIgmpProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyIgmpProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyIgmpProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "last_member_query_interval", accTestPolicyIgmpProfileUpdateAttributes["last_member_query_interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "query_interval", accTestPolicyIgmpProfileUpdateAttributes["query_interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "query_max_response_time", accTestPolicyIgmpProfileUpdateAttributes["query_max_response_time"]),
+					resource.TestCheckResourceAttr(testResourceName, "robustness_variable", accTestPolicyIgmpProfileUpdateAttributes["robustness_variable"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIgmpProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIgmpProfileExists(accTestPolicyIgmpProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "query_interval", "30"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIgmpProfile_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_igmp_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIgmpProfileCheckDestroy(state, accTestPolicyIgmpProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIgmpProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIgmpProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy IgmpProfile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy IgmpProfile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyIgmpProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy IgmpProfile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyIgmpProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_igmp_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyIgmpProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy IgmpProfile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyIgmpProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyIgmpProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyIgmpProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_igmp_profile" "test" {
+  display_name               = "%s"
+  description                = "%s"
+  last_member_query_interval = %s
+  query_interval             = %s
+  query_max_response_time    = %s
+  robustness_variable        = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["last_member_query_interval"], attrMap["query_interval"], attrMap["query_max_response_time"], attrMap["robustness_variable"])
+}
+
+func testAccNsxtPolicyIgmpProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_igmp_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyIgmpProfileUpdateAttributes["display_name"])
+}
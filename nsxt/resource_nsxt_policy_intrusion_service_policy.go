@@ -36,7 +36,7 @@ func resourceNsxtPolicyIntrusionServicePolicy() *schema.Resource {
 func getIdsProfilesSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeSet,
-		Description: "List of policy Paths for IDS Profiles",
+		Description: "List of policy Paths for IDS or Malware Prevention Profiles",
 		Required:    true,
 		Elem: &schema.Schema{
 			Type:         schema.TypeString,
@@ -5,13 +5,9 @@ package nsxt
 
 import (
 	"fmt"
-	"log"
 	"testing"
 
-	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -45,49 +41,13 @@ func getMacDiscoveryProfileTestCheckFunc(testResourceName string, create bool) [
 		resource.TestCheckResourceAttrSet(testResourceName, "revision"),
 		resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
 	}
-	for key, item := range macDiscoveryProfileSchema {
-		if item.Metadata.Skip {
-			continue
-		}
-		if item.Metadata.IntroducedInVersion != "" && util.NsxVersionLower(item.Metadata.IntroducedInVersion) {
-			continue
-		}
-
-		value := item.Metadata.TestData.CreateValue
-		if !create {
-			value = item.Metadata.TestData.UpdateValue
-		}
-		result = append(result, resource.TestCheckResourceAttr(testResourceName, key, value.(string)))
-	}
+	result = append(result, getPolicyGenericResourceTestCheckFuncs(macDiscoveryProfileSchema, testResourceName, create)...)
 
 	return result
 }
 
 func getMacDiscoveryProfileTestConfigAttributes(create bool) string {
-	result := ""
-	schemaDef := resourceNsxtPolicyMacDiscoveryProfile().Schema
-	for key, item := range macDiscoveryProfileSchema {
-		if item.Metadata.Skip {
-			continue
-		}
-		log.Printf("[INFO] inspecting schema test key %s", key)
-		if item.Metadata.IntroducedInVersion != "" && util.NsxVersionLower(item.Metadata.IntroducedInVersion) {
-			continue
-		}
-
-		value := item.Metadata.TestData.CreateValue
-		if !create {
-			value = item.Metadata.TestData.UpdateValue
-		}
-
-		if schemaDef[key].Type == schema.TypeString {
-			result += fmt.Sprintf("\n %s = \"%s\"", key, value.(string))
-		} else {
-			result += fmt.Sprintf("\n %s = %s", key, value.(string))
-		}
-	}
-
-	return result
+	return getPolicyGenericResourceTestConfigAttributes(macDiscoveryProfileSchema, create)
 }
 
 func testAccResourceNsxtPolicyMacDiscoveryProfileBasic(t *testing.T, withContext bool, preCheck func()) {
@@ -171,51 +131,11 @@ func TestAccResourceNsxtPolicyMacDiscoveryProfile_importBasic_multitenancy(t *te
 }
 
 func testAccNsxtPolicyMacDiscoveryProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
-	return func(state *terraform.State) error {
-
-		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
-
-		rs, ok := state.RootModule().Resources[resourceName]
-		if !ok {
-			return fmt.Errorf("Policy MacDiscoveryProfile resource %s not found in resources", resourceName)
-		}
-
-		resourceID := rs.Primary.ID
-		if resourceID == "" {
-			return fmt.Errorf("Policy MacDiscoveryProfile resource ID not set in resources")
-		}
-
-		exists, err := resourceNsxtPolicyMacDiscoveryProfileExists(testAccGetSessionContext(), resourceID, connector)
-		if err != nil {
-			return err
-		}
-		if !exists {
-			return fmt.Errorf("Policy MacDiscoveryProfile %s does not exist", resourceID)
-		}
-
-		return nil
-	}
+	return testAccNsxtPolicyGenericResourceExists(policyMacDiscoveryProfileResource, resourceName)
 }
 
 func testAccNsxtPolicyMacDiscoveryProfileCheckDestroy(state *terraform.State, displayName string) error {
-	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
-	for _, rs := range state.RootModule().Resources {
-
-		if rs.Type != "nsxt_policy_mac_discovery_profile" {
-			continue
-		}
-
-		resourceID := rs.Primary.Attributes["id"]
-		exists, err := resourceNsxtPolicyMacDiscoveryProfileExists(testAccGetSessionContext(), resourceID, connector)
-		if err == nil {
-			return err
-		}
-
-		if exists {
-			return fmt.Errorf("Policy MacDiscoveryProfile %s still exists", displayName)
-		}
-	}
-	return nil
+	return testAccNsxtPolicyGenericResourceCheckDestroy(policyMacDiscoveryProfileResource, "nsxt_policy_mac_discovery_profile")(state)
 }
 
 func testAccNsxtPolicyMacDiscoveryProfileTemplate(createFlow, withContext bool) string {
@@ -0,0 +1,182 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security/malware_prevention_service"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var malwarePreventionProfileDetectionTypeValues = []string{
+	model.MalwarePreventionProfile_DETECTION_TYPE_BASED,
+	model.MalwarePreventionProfile_DETECTION_TYPE_AND_SANDBOXING_BASED,
+}
+
+var malwarePreventionProfileFileTypeValues = []string{
+	model.MalwarePreventionProfile_FILE_TYPE_DOCUMENT,
+	model.MalwarePreventionProfile_FILE_TYPE_EXECUTABLE,
+	model.MalwarePreventionProfile_FILE_TYPE_MEDIA,
+	model.MalwarePreventionProfile_FILE_TYPE_ARCHIVE,
+	model.MalwarePreventionProfile_FILE_TYPE_DATA,
+	model.MalwarePreventionProfile_FILE_TYPE_SCRIPT,
+	model.MalwarePreventionProfile_FILE_TYPE_OTHER,
+}
+
+func resourceNsxtPolicyMalwarePreventionProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyMalwarePreventionProfileCreate,
+		Read:   resourceNsxtPolicyMalwarePreventionProfileRead,
+		Update: resourceNsxtPolicyMalwarePreventionProfileUpdate,
+		Delete: resourceNsxtPolicyMalwarePreventionProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"detection_type": {
+				Type:         schema.TypeString,
+				Description:  "Malware detection method",
+				Optional:     true,
+				Default:      model.MalwarePreventionProfile_DETECTION_TYPE_BASED,
+				ValidateFunc: validation.StringInSlice(malwarePreventionProfileDetectionTypeValues, false),
+			},
+			"file_types": {
+				Type:        schema.TypeSet,
+				Description: "File categories to inspect",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(malwarePreventionProfileFileTypeValues, false),
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyMalwarePreventionProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := malware_prevention_service.NewProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Malware Prevention Profile", err)
+}
+
+func policyMalwarePreventionProfilePatch(id string, d *schema.ResourceData, connector client.Connector) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	detectionType := d.Get("detection_type").(string)
+
+	obj := model.MalwarePreventionProfile{
+		DisplayName:   &displayName,
+		Description:   &description,
+		Tags:          tags,
+		DetectionType: &detectionType,
+		FileType:      getStringListFromSchemaSet(d, "file_types"),
+	}
+
+	client := malware_prevention_service.NewProfilesClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyMalwarePreventionProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyMalwarePreventionProfileExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Malware Prevention Profile with ID %s", id)
+	err = policyMalwarePreventionProfilePatch(id, d, connector)
+	if err != nil {
+		return handleCreateError("Malware Prevention Profile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyMalwarePreventionProfileRead(d, m)
+}
+
+func resourceNsxtPolicyMalwarePreventionProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Malware Prevention Profile ID")
+	}
+
+	client := malware_prevention_service.NewProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "Malware Prevention Profile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("detection_type", obj.DetectionType)
+	d.Set("file_types", obj.FileType)
+
+	return nil
+}
+
+func resourceNsxtPolicyMalwarePreventionProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Malware Prevention Profile ID")
+	}
+
+	log.Printf("[INFO] Updating Malware Prevention Profile with ID %s", id)
+	err := policyMalwarePreventionProfilePatch(id, d, connector)
+	if err != nil {
+		return handleUpdateError("Malware Prevention Profile", id, err)
+	}
+
+	return resourceNsxtPolicyMalwarePreventionProfileRead(d, m)
+}
+
+func resourceNsxtPolicyMalwarePreventionProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Malware Prevention Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := malware_prevention_service.NewProfilesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("Malware Prevention Profile", id, err)
+	}
+
+	return nil
+}
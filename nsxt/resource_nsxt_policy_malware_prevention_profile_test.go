@@ -0,0 +1,151 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccPreCheckMalwarePrevention(t *testing.T) {
+	testAccPreCheck(t)
+	testAccOnlyLocalManager(t)
+	testAccNSXVersion(t, "4.1.0")
+}
+
+func TestAccResourceNsxtPolicyMalwarePreventionProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_malware_prevention_profile.test"
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckMalwarePrevention(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyMalwarePreventionProfileCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyMalwarePreventionProfileTemplate(name, "SIGNATURE_BASED", `["EXECUTABLE", "DOCUMENT"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyMalwarePreventionProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "detection_type", "SIGNATURE_BASED"),
+					resource.TestCheckResourceAttr(testResourceName, "file_types.#", "2"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyMalwarePreventionProfileTemplate(updatedName, "SIGNATURE_AND_SANDBOXING_BASED", `["EXECUTABLE", "DOCUMENT", "ARCHIVE"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyMalwarePreventionProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "detection_type", "SIGNATURE_AND_SANDBOXING_BASED"),
+					resource.TestCheckResourceAttr(testResourceName, "file_types.#", "3"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyMalwarePreventionProfile_inIdsRule(t *testing.T) {
+	testResourceName := "nsxt_policy_intrusion_service_policy.test"
+	name := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckMalwarePrevention(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyMalwarePreventionProfileCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyMalwarePreventionProfileTemplate(name, "SIGNATURE_BASED", `["EXECUTABLE"]`) + fmt.Sprintf(`
+resource "nsxt_policy_intrusion_service_policy" "test" {
+  display_name = "%s"
+
+  rule {
+    display_name = "mps"
+    action       = "DETECT"
+    ids_profiles = [nsxt_policy_malware_prevention_profile.test.path]
+  }
+}`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, "rule.0.ids_profiles.0", "nsxt_policy_malware_prevention_profile.test", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyMalwarePreventionProfileExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Malware Prevention Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Malware Prevention Profile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyMalwarePreventionProfileExists(resourceID, connector, false)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Malware Prevention Profile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyMalwarePreventionProfileCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_malware_prevention_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyMalwarePreventionProfileExists(resourceID, connector, false)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Malware Prevention Profile %s still exists", resourceID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyMalwarePreventionProfileTemplate(name string, detectionType string, fileTypes string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_malware_prevention_profile" "test" {
+  display_name   = "%s"
+  detection_type = "%s"
+  file_types     = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, detectionType, fileTypes)
+}
@@ -4,6 +4,7 @@
 package nsxt
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -49,6 +50,7 @@ func resourceNsxtPolicyNATRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyNATRuleImport,
 		},
+		CustomizeDiff: resourceNsxtPolicyNATRuleCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":       getNsxIDSchema(),
@@ -252,6 +254,24 @@ func getTranslatedNetworks(rule model.PolicyNatRule) (*string, error) {
 	return tNets, nil
 }
 
+func resourceNsxtPolicyNATRuleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return validateNat64Rule(d)
+}
+
+// NAT64 translates IPv6 destinations to IPv4 addresses, both must be explicit
+func validateNat64Rule(d *schema.ResourceDiff) error {
+	if d.Get("action").(string) != model.PolicyNatRule_ACTION_NAT64 {
+		return nil
+	}
+	for _, attr := range []string{"destination_networks", "translated_networks"} {
+		// Values known only at apply time can not be validated
+		if d.NewValueKnown(attr) && len(d.Get(attr).([]interface{})) == 0 {
+			return fmt.Errorf("%s must be specified for NAT64 rule", attr)
+		}
+	}
+	return nil
+}
+
 func policyBasedVpnModeNeeded(action string) bool {
 	return action == model.PolicyNatRule_ACTION_DNAT || action == model.PolicyNatRule_ACTION_NO_DNAT
 }
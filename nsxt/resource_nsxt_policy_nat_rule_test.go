@@ -4,7 +4,9 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -306,6 +308,25 @@ func TestAccResourceNsxtPolicyNATRule_basicT1Import_multitenancy(t *testing.T) {
 	})
 }
 
+func TestPolicyNATRuleNat64Validation(t *testing.T) {
+	res := resourceNsxtPolicyNATRule()
+	config := map[string]interface{}{
+		"display_name":         "nat64",
+		"gateway_path":         "/infra/tier-1s/t1",
+		"action":               model.PolicyNatRule_ACTION_NAT64,
+		"destination_networks": []interface{}{"2001:db8:122:344::/96"},
+	}
+	_, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	if err == nil || !strings.Contains(err.Error(), "translated_networks") {
+		t.Errorf("Expected plan of NAT64 rule without translated_networks to fail, got %v", err)
+	}
+
+	config["translated_networks"] = []interface{}{"44.1.1.2"}
+	if _, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil); err != nil {
+		t.Errorf("Expected plan of NAT64 rule to succeed, got %v", err)
+	}
+}
+
 func TestAccResourceNsxtPolicyNATRule_nat64T1(t *testing.T) {
 	name := getAccTestResourceName()
 	updateName := getAccTestResourceName()
@@ -366,6 +387,57 @@ func TestAccResourceNsxtPolicyNATRule_nat64T1(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyNATRule_nat64T0(t *testing.T) {
+	name := getAccTestResourceName()
+	updateName := getAccTestResourceName()
+	dnet := "2001:db8:122:344::/96"
+	tnet := "44.1.1.2"
+	tnet1 := "44.1.1.3"
+	action := model.PolicyNatRule_ACTION_NAT64
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyNATRuleCheckDestroy(state, updateName, true)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyNATRuleTier0Nat64Template(name, dnet, tnet),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyNATRuleExists(testAccResourcePolicyNATRuleName, true),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "display_name", name),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "destination_networks.#", "1"),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "translated_networks.#", "1"),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "destination_networks.0", dnet),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "translated_networks.0", tnet),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "action", action),
+					resource.TestCheckResourceAttrSet(testAccResourcePolicyNATRuleName, "path"),
+					resource.TestCheckResourceAttrSet(testAccResourcePolicyNATRuleName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyNATRuleTier0Nat64Template(updateName, dnet, tnet1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyNATRuleExists(testAccResourcePolicyNATRuleName, true),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "translated_networks.0", tnet1),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "action", action),
+				),
+			},
+			{
+				ResourceName:      testAccResourcePolicyNATRuleName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testAccResourcePolicyNATRuleName),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtPolicyNATRuleNoSnatWithoutTNet(t *testing.T) {
 	name := getAccTestResourceName()
 	updateName := getAccTestResourceName()
@@ -503,6 +575,19 @@ resource "nsxt_policy_nat_rule" "test" {
 `, name, model.PolicyNatRule_ACTION_REFLEXIVE, sourceNet, translatedNet)
 }
 
+func testAccNsxtPolicyNATRuleTier0Nat64Template(name string, destNet string, translatedNet string) string {
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+		testAccNsxtPolicyTier0WithEdgeClusterTemplate("test", false) + fmt.Sprintf(`
+resource "nsxt_policy_nat_rule" "test" {
+  display_name         = "%s"
+  gateway_path         = nsxt_policy_tier0_gateway.test.path
+  action               = "NAT64"
+  destination_networks = ["%s"]
+  translated_networks  = ["%s"]
+}
+`, name, destNet, translatedNet)
+}
+
 func testAccNsxtPolicyNATRuleTier1CreateTemplate(name string, action string, sourceNet string, destNet string, translatedNet string, withContext bool) string {
 	context := ""
 	if withContext {
@@ -83,6 +83,12 @@ func getPolicyOspfConfigSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"ignore_summary_address": {
+			Type:          schema.TypeBool,
+			Description:   "Do not manage summary addresses, preserving those configured on NSX, for example by nsxt_policy_ospf_summary_address resources",
+			Optional:      true,
+			ConflictsWith: []string{"summary_address"},
+		},
 		"locale_service_id": getComputedLocaleServiceIDSchema(),
 		"gateway_id":        getComputedGatewayIDSchema(),
 	}
@@ -96,6 +102,8 @@ func policyOspfConfigPatch(d *schema.ResourceData, m interface{}, gwID string, l
 	enabled := d.Get("enabled").(bool)
 	defaultOriginate := d.Get("default_originate").(bool)
 	gracefulRestartMode := d.Get("graceful_restart_mode").(string)
+	// With ignore_summary_address, summary addresses are not specified, hence
+	// patch preserves those configured on NSX
 	summaryAddresses := d.Get("summary_address").([]interface{})
 	var addresses []model.OspfSummaryAddressConfig
 
@@ -192,11 +200,13 @@ func resourceNsxtPolicyOspfConfigRead(d *schema.ResourceData, m interface{}) err
 	d.Set("graceful_restart_mode", obj.GracefulRestartMode)
 
 	var summaryAddresses []map[string]interface{}
-	for _, address := range obj.SummaryAddresses {
-		data := make(map[string]interface{})
-		data["prefix"] = address.Prefix
-		data["advertise"] = address.Advertise
-		summaryAddresses = append(summaryAddresses, data)
+	if !d.Get("ignore_summary_address").(bool) {
+		for _, address := range obj.SummaryAddresses {
+			data := make(map[string]interface{})
+			data["prefix"] = address.Prefix
+			data["advertise"] = address.Advertise
+			summaryAddresses = append(summaryAddresses, data)
+		}
 	}
 
 	d.Set("summary_address", summaryAddresses)
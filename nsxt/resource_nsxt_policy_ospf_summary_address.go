@@ -0,0 +1,47 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyOspfSummaryAddressResource = policyGatewayPrefixEntryResource[model.OspfSummaryAddressConfig]{
+	name:              "OSPF Summary Address",
+	prefixDescription: "OSPF summary address in CIDR format",
+	flagAttribute:     "advertise",
+	flagDescription:   "Used to filter the advertisement of external routes into the OSPF domain",
+	readEntries: func(connector client.Connector, gwID string, localeServiceID string) ([]model.OspfSummaryAddressConfig, *string, error) {
+		obj, err := locale_services.NewOspfClient(connector).Get(gwID, localeServiceID)
+		return obj.SummaryAddresses, obj.Path, err
+	},
+	updateEntries: func(connector client.Connector, gwID string, localeServiceID string, modify func([]model.OspfSummaryAddressConfig) ([]model.OspfSummaryAddressConfig, error)) error {
+		client := locale_services.NewOspfClient(connector)
+		obj, err := client.Get(gwID, localeServiceID)
+		if err != nil {
+			return err
+		}
+		obj.SummaryAddresses, err = modify(obj.SummaryAddresses)
+		if err != nil {
+			return err
+		}
+		_, err = client.Update(gwID, localeServiceID, obj)
+		return err
+	},
+	getEntryPrefix: func(entry model.OspfSummaryAddressConfig) *string { return entry.Prefix },
+	getEntryFlag:   func(entry model.OspfSummaryAddressConfig) *bool { return entry.Advertise },
+	newEntry: func(prefix string, advertise bool) model.OspfSummaryAddressConfig {
+		return model.OspfSummaryAddressConfig{
+			Prefix:    &prefix,
+			Advertise: &advertise,
+		}
+	},
+}
+
+func resourceNsxtPolicyOspfSummaryAddress() *schema.Resource {
+	return policyOspfSummaryAddressResource.resource()
+}
@@ -0,0 +1,120 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services"
+)
+
+func TestAccResourceNsxtPolicyOspfSummaryAddress_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_ospf_summary_address.test"
+	otherResourceName := "nsxt_policy_ospf_summary_address.other"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccNSXVersion(t, "3.1.1")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyOspfSummaryAddressCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyOspfSummaryAddressTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyOspfSummaryAddressExists(testResourceName),
+					testAccNsxtPolicyOspfSummaryAddressExists(otherResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "prefix", "20.1.0.0/16"),
+					resource.TestCheckResourceAttr(testResourceName, "advertise", "true"),
+					resource.TestCheckResourceAttr(otherResourceName, "prefix", "20.2.0.0/16"),
+					resource.TestCheckResourceAttrSet(testResourceName, "gateway_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "locale_service_id"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyOspfSummaryAddressTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyOspfSummaryAddressExists(testResourceName),
+					testAccNsxtPolicyOspfSummaryAddressExists(otherResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "advertise", "false"),
+					resource.TestCheckResourceAttr(otherResourceName, "advertise", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyOspfSummaryAddressExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy OSPF Summary Address resource %s not found in resources", resourceName)
+		}
+
+		gwID := rs.Primary.Attributes["gateway_id"]
+		localeServiceID := rs.Primary.Attributes["locale_service_id"]
+		prefix := rs.Primary.Attributes["prefix"]
+		obj, err := locale_services.NewOspfClient(connector).Get(gwID, localeServiceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving OSPF config for gateway %s: %v", gwID, err)
+		}
+		for _, entry := range obj.SummaryAddresses {
+			if entry.Prefix != nil && *entry.Prefix == prefix {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Policy OSPF Summary Address %s does not exist", prefix)
+	}
+}
+
+func testAccNsxtPolicyOspfSummaryAddressCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_ospf_summary_address" {
+			continue
+		}
+
+		gwID := rs.Primary.Attributes["gateway_id"]
+		localeServiceID := rs.Primary.Attributes["locale_service_id"]
+		prefix := rs.Primary.Attributes["prefix"]
+		obj, err := locale_services.NewOspfClient(connector).Get(gwID, localeServiceID)
+		if err != nil {
+			// Gateway is gone as well
+			continue
+		}
+		for _, entry := range obj.SummaryAddresses {
+			if entry.Prefix != nil && *entry.Prefix == prefix {
+				return fmt.Errorf("Policy OSPF Summary Address %s still exists", prefix)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyOspfSummaryAddressTemplate(advertise bool) string {
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+		testAccNsxtPolicyTier0WithEdgeClusterTemplate("test", false) + fmt.Sprintf(`
+resource "nsxt_policy_ospf_summary_address" "test" {
+  gateway_path = nsxt_policy_tier0_gateway.test.path
+  prefix       = "20.1.0.0/16"
+  advertise = %t
+}
+
+resource "nsxt_policy_ospf_summary_address" "other" {
+  gateway_path = nsxt_policy_tier0_gateway.test.path
+  prefix       = "20.2.0.0/16"
+  depends_on   = [nsxt_policy_ospf_summary_address.test]
+}`, advertise)
+}
@@ -0,0 +1,198 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyPimProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyPimProfileCreate,
+		Read:   resourceNsxtPolicyPimProfileRead,
+		Update: resourceNsxtPolicyPimProfileUpdate,
+		Delete: resourceNsxtPolicyPimProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"bsm_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enable bootstrap messaging (BSR)",
+			},
+			"rp_address_multicast_ranges": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Static rendezvous point addresses and associated multicast group ranges",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rp_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Static IPv4 rendezvous point address",
+							ValidateFunc: validateSingleIP(),
+						},
+						"multicast_ranges": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Multicast group ranges associated with the rendezvous point",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateCidr(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyPimProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewPimProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getPimProfileFromSchema(d *schema.ResourceData) model.PolicyPimProfile {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	bsmEnabled := d.Get("bsm_enabled").(bool)
+
+	var rpRanges []model.RpAddressMulticastRanges
+	for _, item := range d.Get("rp_address_multicast_ranges").([]interface{}) {
+		data := item.(map[string]interface{})
+		rpAddress := data["rp_address"].(string)
+		rpRanges = append(rpRanges, model.RpAddressMulticastRanges{
+			RpAddress:       &rpAddress,
+			MulticastRanges: interfaceListToStringList(data["multicast_ranges"].([]interface{})),
+		})
+	}
+
+	return model.PolicyPimProfile{
+		DisplayName:              &displayName,
+		Description:              &description,
+		Tags:                     tags,
+		BsmEnabled:               &bsmEnabled,
+		RpAddressMulticastRanges: rpRanges,
+	}
+}
+
+func resourceNsxtPolicyPimProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyPimProfileExists)
+	if err != nil {
+		return err
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewPimProfilesClient(connector)
+	obj := getPimProfileFromSchema(d)
+	err = client.Patch(id, obj)
+	if err != nil {
+		return handleCreateError("PimProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyPimProfileRead(d, m)
+}
+
+func resourceNsxtPolicyPimProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining PimProfile ID")
+	}
+
+	client := infra.NewPimProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "PimProfile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("bsm_enabled", obj.BsmEnabled)
+
+	var rpRanges []map[string]interface{}
+	for _, item := range obj.RpAddressMulticastRanges {
+		elem := make(map[string]interface{})
+		elem["rp_address"] = item.RpAddress
+		elem["multicast_ranges"] = item.MulticastRanges
+		rpRanges = append(rpRanges, elem)
+	}
+	d.Set("rp_address_multicast_ranges", rpRanges)
+
+	return nil
+}
+
+func resourceNsxtPolicyPimProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining PimProfile ID")
+	}
+
+	client := infra.NewPimProfilesClient(connector)
+	obj := getPimProfileFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+	_, err := client.Update(id, obj)
+	if err != nil {
+		return handleUpdateError("PimProfile", id, err)
+	}
+
+	return resourceNsxtPolicyPimProfileRead(d, m)
+}
+
+func resourceNsxtPolicyPimProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining PimProfile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewPimProfilesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("PimProfile", id, err)
+	}
+
+	return nil
+}
@@ -36,11 +36,13 @@ func resourceNsxtPolicyPredefinedGatewayPolicy() *schema.Resource {
 }
 
 func getPolicyPredefinedGatewayPolicySchema() map[string]*schema.Schema {
+	ruleSchema := getSecurityPolicyAndGatewayRulesSchema(true, false, false)
+	removePolicyRuleAnchorsFromSchema(ruleSchema.Elem.(*schema.Resource).Schema)
 	return map[string]*schema.Schema{
 		"path":         getPolicyPathSchema(true, true, "Path for this Gateway Policy"),
 		"description":  getComputedDescriptionSchema(),
 		"tag":          getTagsSchema(),
-		"rule":         getSecurityPolicyAndGatewayRulesSchema(true, false, false),
+		"rule":         ruleSchema,
 		"default_rule": getGatewayPolicyDefaultRulesSchema(),
 		"revision":     getRevisionSchema(),
 		"context":      getContextSchema(false, false, false),
@@ -330,7 +332,10 @@ func updatePolicyPredefinedGatewayPolicy(id string, d *schema.ResourceData, m in
 	var childRules []*data.StructValue
 	if d.HasChange("rule") {
 		oldRules, _ := d.GetChange("rule")
-		rules := getPolicyRulesFromSchema(d)
+		rules, err := getPolicyRulesFromSchema(d)
+		if err != nil {
+			return err
+		}
 
 		existingRules := make(map[string]bool)
 		for _, rule := range rules {
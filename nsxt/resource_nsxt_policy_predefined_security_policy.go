@@ -79,11 +79,13 @@ func getSecurityPolicyDefaultRulesSchema() *schema.Schema {
 }
 
 func getPolicyPredefinedSecurityPolicySchema() map[string]*schema.Schema {
+	ruleSchema := getSecurityPolicyAndGatewayRulesSchema(false, false, false)
+	removePolicyRuleAnchorsFromSchema(ruleSchema.Elem.(*schema.Resource).Schema)
 	return map[string]*schema.Schema{
 		"path":         getPolicyPathSchema(true, true, "Path for this Security Policy"),
 		"description":  getComputedDescriptionSchema(),
 		"tag":          getTagsSchema(),
-		"rule":         getSecurityPolicyAndGatewayRulesSchema(false, false, false),
+		"rule":         ruleSchema,
 		"default_rule": getSecurityPolicyDefaultRulesSchema(),
 		"revision":     getRevisionSchema(),
 		"context":      getContextSchema(false, false, false),
@@ -291,7 +293,10 @@ func updatePolicyPredefinedSecurityPolicy(id string, d *schema.ResourceData, m i
 	var childRules []*data.StructValue
 	if d.HasChange("rule") {
 		oldRules, _ := d.GetChange("rule")
-		rules := getPolicyRulesFromSchema(d)
+		rules, err := getPolicyRulesFromSchema(d)
+		if err != nil {
+			return err
+		}
 
 		existingRules := make(map[string]bool)
 		for _, rule := range rules {
@@ -0,0 +1,358 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains/redirection_policies"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyRedirectionRuleActionValues = []string{
+	model.RedirectionRule_ACTION_REDIRECT,
+	model.RedirectionRule_ACTION_DO_NOT_REDIRECT,
+}
+
+func resourceNsxtPolicyRedirectionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyRedirectionPolicyCreate,
+		Read:   resourceNsxtPolicyRedirectionPolicyRead,
+		Update: resourceNsxtPolicyRedirectionPolicyUpdate,
+		Delete: resourceNsxtPolicyRedirectionPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtDomainResourceImporter,
+		},
+
+		Schema: getPolicyRedirectionPolicySchema(),
+	}
+}
+
+func getPolicyRedirectionPolicySchema() map[string]*schema.Schema {
+	// Redirection rules share semantics with DFW rules, except for the action
+	ruleSchema := getSecurityPolicyAndGatewayRuleSchema(false, false, true, false)
+	removePolicyRuleAnchorsFromSchema(ruleSchema)
+	ruleSchema["action"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Action",
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(policyRedirectionRuleActionValues, false),
+		Default:      model.RedirectionRule_ACTION_REDIRECT,
+	}
+
+	return map[string]*schema.Schema{
+		"nsx_id":       getNsxIDSchema(),
+		"path":         getPathSchema(),
+		"display_name": getDisplayNameSchema(),
+		"description":  getDescriptionSchema(),
+		"revision":     getRevisionSchema(),
+		"tag":          getTagsSchema(),
+		"domain":       getDomainNameSchema(),
+		"north_south": {
+			Type:        schema.TypeBool,
+			Description: "Whether this policy redirects north-south traffic, as opposed to east-west",
+			Optional:    true,
+			Default:     false,
+			ForceNew:    true,
+		},
+		"redirect_to": {
+			Type:        schema.TypeList,
+			Description: "Policy path of the service chain or service instance traffic is redirected to",
+			Required:    true,
+			MaxItems:    1,
+			Elem:        getElemPolicyPathSchema(),
+		},
+		"comments": {
+			Type:        schema.TypeString,
+			Description: "Comments for redirection policy lock/unlock",
+			Optional:    true,
+		},
+		"locked": {
+			Type:        schema.TypeBool,
+			Description: "Indicates whether a redirection policy should be locked. If locked by a user, no other user would be able to modify this policy",
+			Optional:    true,
+			Default:     false,
+		},
+		"scope": {
+			Type:        schema.TypeSet,
+			Description: "The list of group paths where the rules in this policy will get applied",
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validatePolicyPath(),
+			},
+		},
+		"sequence_number": {
+			Type:        schema.TypeInt,
+			Description: "This field is used to resolve conflicts between redirection policies",
+			Optional:    true,
+			Default:     0,
+		},
+		"rule": {
+			Type:        schema.TypeList,
+			Description: "List of rules in the section",
+			Optional:    true,
+			MaxItems:    1000,
+			Elem: &schema.Resource{
+				Schema: ruleSchema,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyRedirectionPolicyExistsPartial(domainName string) func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+		client := domains.NewRedirectionPoliciesClient(connector)
+		_, err := client.Get(domainName, id)
+		if err == nil {
+			return true, nil
+		}
+
+		if isNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, logAPIError("Error retrieving Redirection Policy", err)
+	}
+}
+
+func getPolicyRedirectionRulesFromSchema(d *schema.ResourceData) ([]model.RedirectionRule, error) {
+	rules, err := getPolicyRulesFromSchema(d)
+	if err != nil {
+		return nil, err
+	}
+	resourceType := "RedirectionRule"
+	var redirectionRules []model.RedirectionRule
+	for _, rule := range rules {
+		redirectionRules = append(redirectionRules, model.RedirectionRule{
+			ResourceType:         &resourceType,
+			Id:                   rule.Id,
+			DisplayName:          rule.DisplayName,
+			Notes:                rule.Notes,
+			Description:          rule.Description,
+			Action:               rule.Action,
+			Logged:               rule.Logged,
+			Tag:                  rule.Tag,
+			Tags:                 rule.Tags,
+			Disabled:             rule.Disabled,
+			SourcesExcluded:      rule.SourcesExcluded,
+			DestinationsExcluded: rule.DestinationsExcluded,
+			IpProtocol:           rule.IpProtocol,
+			Direction:            rule.Direction,
+			SourceGroups:         rule.SourceGroups,
+			DestinationGroups:    rule.DestinationGroups,
+			Services:             rule.Services,
+			Scope:                rule.Scope,
+			Profiles:             rule.Profiles,
+			SequenceNumber:       rule.SequenceNumber,
+		})
+	}
+	return redirectionRules, nil
+}
+
+func setPolicyRedirectionRulesInSchema(d *schema.ResourceData, redirectionRules []model.RedirectionRule) error {
+	var rules []model.Rule
+	for _, rule := range redirectionRules {
+		rules = append(rules, model.Rule{
+			Id:                   rule.Id,
+			Path:                 rule.Path,
+			Revision:             rule.Revision,
+			RuleId:               rule.RuleId,
+			DisplayName:          rule.DisplayName,
+			Notes:                rule.Notes,
+			Description:          rule.Description,
+			Action:               rule.Action,
+			Logged:               rule.Logged,
+			Tag:                  rule.Tag,
+			Tags:                 rule.Tags,
+			Disabled:             rule.Disabled,
+			SourcesExcluded:      rule.SourcesExcluded,
+			DestinationsExcluded: rule.DestinationsExcluded,
+			IpProtocol:           rule.IpProtocol,
+			Direction:            rule.Direction,
+			SourceGroups:         rule.SourceGroups,
+			DestinationGroups:    rule.DestinationGroups,
+			Services:             rule.Services,
+			Scope:                rule.Scope,
+			Profiles:             rule.Profiles,
+			SequenceNumber:       rule.SequenceNumber,
+		})
+	}
+	return setPolicyRulesInSchema(d, rules)
+}
+
+// Rules that were removed from configuration need to be deleted explicitly,
+// since PATCH only creates or updates the rules it carries
+func getPolicyRedirectionRulesToDelete(d *schema.ResourceData, rules []model.RedirectionRule) []string {
+	if !d.HasChange("rule") {
+		return nil
+	}
+	currentIDs := make(map[string]bool)
+	for _, rule := range rules {
+		currentIDs[*rule.Id] = true
+	}
+
+	var result []string
+	oldRules, _ := d.GetChange("rule")
+	for _, oldRule := range oldRules.([]interface{}) {
+		oldRuleID := oldRule.(map[string]interface{})["nsx_id"].(string)
+		if oldRuleID != "" && !currentIDs[oldRuleID] {
+			result = append(result, oldRuleID)
+		}
+	}
+	return result
+}
+
+func policyRedirectionPolicyPatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	domain := d.Get("domain").(string)
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	northSouth := d.Get("north_south").(bool)
+	comments := d.Get("comments").(string)
+	locked := d.Get("locked").(bool)
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+	objType := "RedirectionPolicy"
+
+	rules, err := getPolicyRedirectionRulesFromSchema(d)
+	if err != nil {
+		return err
+	}
+	obj := model.RedirectionPolicy{
+		Id:             &id,
+		DisplayName:    &displayName,
+		Description:    &description,
+		Tags:           tags,
+		NorthSouth:     &northSouth,
+		RedirectTo:     getStringListFromSchemaList(d, "redirect_to"),
+		Comments:       &comments,
+		Locked:         &locked,
+		Scope:          getStringListFromSchemaSet(d, "scope"),
+		SequenceNumber: &sequenceNumber,
+		ResourceType:   &objType,
+		Rules:          rules,
+	}
+
+	client := domains.NewRedirectionPoliciesClient(connector)
+	err = client.Patch(domain, id, obj)
+	if err != nil {
+		return err
+	}
+
+	ruleClient := redirection_policies.NewRulesClient(connector)
+	for _, ruleID := range getPolicyRedirectionRulesToDelete(d, rules) {
+		log.Printf("[DEBUG]: Deleting redirection rule with id %s", ruleID)
+		err = ruleClient.Delete(domain, id, ruleID)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyRedirectionPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	domain := d.Get("domain").(string)
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyRedirectionPolicyExistsPartial(domain))
+	if err != nil {
+		return err
+	}
+
+	if err := validatePolicyRuleSequence(d); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Redirection Policy with ID %s", id)
+	err = policyRedirectionPolicyPatch(d, m, id)
+	if err != nil {
+		return handleCreateError("Redirection Policy", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyRedirectionPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyRedirectionPolicyRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Redirection Policy ID")
+	}
+	domain := d.Get("domain").(string)
+
+	client := domains.NewRedirectionPoliciesClient(connector)
+	obj, err := client.Get(domain, id)
+	if err != nil {
+		return handleReadError(d, "Redirection Policy", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("domain", getDomainFromResourcePath(*obj.Path))
+
+	d.Set("north_south", obj.NorthSouth)
+	d.Set("redirect_to", obj.RedirectTo)
+	d.Set("comments", obj.Comments)
+	d.Set("locked", obj.Locked)
+	if len(obj.Scope) == 1 && obj.Scope[0] == "ANY" {
+		d.Set("scope", nil)
+	} else {
+		d.Set("scope", obj.Scope)
+	}
+	d.Set("sequence_number", obj.SequenceNumber)
+
+	return setPolicyRedirectionRulesInSchema(d, obj.Rules)
+}
+
+func resourceNsxtPolicyRedirectionPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Redirection Policy ID")
+	}
+
+	if err := validatePolicyRuleSequence(d); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating Redirection Policy with ID %s", id)
+	err := policyRedirectionPolicyPatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("Redirection Policy", id, err)
+	}
+
+	return resourceNsxtPolicyRedirectionPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyRedirectionPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Redirection Policy ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := domains.NewRedirectionPoliciesClient(connector)
+	err := client.Delete(d.Get("domain").(string), id)
+	if err != nil {
+		return handleDeleteError("Redirection Policy", id, err)
+	}
+
+	return nil
+}
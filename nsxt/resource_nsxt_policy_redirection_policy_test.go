@@ -0,0 +1,155 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyRedirectionPolicy_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_redirection_policy.test"
+	name := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckServiceChain(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyRedirectionPolicyCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyRedirectionPolicyTemplate(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyRedirectionPolicyExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "domain", "default"),
+					resource.TestCheckResourceAttr(testResourceName, "north_south", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "redirect_to.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "redirect"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action", "REDIRECT"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.source_groups.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.display_name", "bypass"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.action", "DO_NOT_REDIRECT"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.0.nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyRedirectionPolicyTemplate(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyRedirectionPolicyExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "redirect"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyRedirectionPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Redirection Policy resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Redirection Policy resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyRedirectionPolicyExistsPartial(rs.Primary.Attributes["domain"])(resourceID, connector, false)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Redirection Policy %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyRedirectionPolicyCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_redirection_policy" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyRedirectionPolicyExistsPartial(rs.Primary.Attributes["domain"])(resourceID, connector, false)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Redirection Policy %s still exists", resourceID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyRedirectionPolicyTemplate(name string, withBypassRule bool) string {
+	bypassRule := ""
+	if withBypassRule {
+		bypassRule = `
+  rule {
+    display_name       = "bypass"
+    destination_groups = [nsxt_policy_group.test.path]
+    action             = "DO_NOT_REDIRECT"
+  }`
+	}
+	return testAccNsxtPolicyServiceChainTemplate(name, "ALLOW", "ANY") + fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_redirection_policy" "test" {
+  display_name = "%s"
+  redirect_to  = [nsxt_policy_service_chain.test.path]
+
+  rule {
+    display_name  = "redirect"
+    source_groups = [nsxt_policy_group.test.path]
+    action        = "REDIRECT"
+  }
+%s
+}`, name, name, bypassRule)
+}
+
+func TestPolicyRedirectionPolicyUpdateRuleSequence(t *testing.T) {
+	res := resourceNsxtPolicyRedirectionPolicy()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"display_name": "test",
+		"redirect_to":  "/infra/service-chains/chain1",
+		"rule": []interface{}{
+			map[string]interface{}{"display_name": "r1", "sequence_number": 20},
+			map[string]interface{}{"display_name": "r2", "sequence_number": 10},
+		},
+	})
+	d.SetId("test")
+
+	err := res.Update(d, nil)
+	if err == nil || !strings.Contains(err.Error(), "consistent with rule order") {
+		t.Errorf("Expected update to fail with inconsistent sequence numbers, got %v", err)
+	}
+}
@@ -6,6 +6,7 @@ package nsxt
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -53,6 +54,9 @@ func resourceNsxtPolicySecurityPolicyRuleCreate(d *schema.ResourceData, m interf
 		return policyResourceNotSupportedError()
 	}
 	rule := securityPolicyRuleSchemaToModel(d, id)
+	if err := setSecurityPolicyRulePosition(d, client, domain, policyID, &rule); err != nil {
+		return handleCreateError("SecurityPolicyRule", fmt.Sprintf("%s/%s", policyPath, id), err)
+	}
 	err = client.Patch(domain, policyID, id, rule)
 	if err != nil {
 		return handleCreateError("SecurityPolicyRule", fmt.Sprintf("%s/%s", policyPath, id), err)
@@ -180,6 +184,114 @@ func resourceNsxtPolicySecurityPolicyRuleRead(d *schema.ResourceData, m interfac
 	}
 
 	securityPolicyRuleModelToSchema(d, rule)
+
+	insertBefore := d.Get("insert_before").(string)
+	insertAfter := d.Get("insert_after").(string)
+	if insertBefore != "" || insertAfter != "" {
+		others, err := listSecurityPolicyRules(client, domain, policyID, id)
+		if err != nil {
+			return handleReadError(d, "SecurityPolicyRule", fmt.Sprintf("%s/%s", policyPath, id), err)
+		}
+		if !isSecurityPolicyRuleInPlace(others, *rule.SequenceNumber, insertBefore, insertAfter) {
+			// Rule was moved away from its anchor, clear the anchor to trigger repositioning
+			log.Printf("[INFO] Security Policy Rule %s is no longer placed next to rule %s%s", id, insertBefore, insertAfter)
+			d.Set("insert_before", "")
+			d.Set("insert_after", "")
+		}
+	}
+	return nil
+}
+
+// listSecurityPolicyRules returns rules of the policy other than the given rule, sorted by sequence number
+func listSecurityPolicyRules(client *securitypolicies.RuleClientContext, domain string, policyID string, excludeID string) ([]model.Rule, error) {
+	var rules []model.Rule
+	var cursor *string
+	for {
+		ruleList, err := client.List(domain, policyID, cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, rule := range ruleList.Results {
+			if rule.Id != nil && *rule.Id != excludeID && rule.SequenceNumber != nil {
+				rules = append(rules, rule)
+			}
+		}
+		cursor = ruleList.Cursor
+		if cursor == nil || *cursor == "" || len(ruleList.Results) == 0 {
+			break
+		}
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		return *rules[i].SequenceNumber < *rules[j].SequenceNumber
+	})
+	return rules, nil
+}
+
+// isSecurityPolicyRuleInPlace checks whether sequence number places the rule on the
+// correct side of its anchor
+func isSecurityPolicyRuleInPlace(others []model.Rule, sequenceNumber int64, insertBefore string, insertAfter string) bool {
+	for _, rule := range others {
+		if *rule.Id == insertBefore {
+			return sequenceNumber < *rule.SequenceNumber
+		}
+		if *rule.Id == insertAfter {
+			return sequenceNumber > *rule.SequenceNumber
+		}
+	}
+	return false
+}
+
+// setSecurityPolicyRulePosition assigns sequence number to the rule next to its anchor rule,
+// or at the end of the policy if neither anchor nor sequence number is specified. Other
+// rules of the policy are never renumbered, since their sequence number might be set in
+// configuration, hence placement fails if there is no room next to the anchor.
+func setSecurityPolicyRulePosition(d *schema.ResourceData, client *securitypolicies.RuleClientContext, domain string, policyID string, rule *model.Rule) error {
+	insertBefore := d.Get("insert_before").(string)
+	insertAfter := d.Get("insert_after").(string)
+	current := int64(d.Get("sequence_number").(int))
+	if insertBefore == "" && insertAfter == "" && current > 0 {
+		return nil
+	}
+
+	others, err := listSecurityPolicyRules(client, domain, policyID, *rule.Id)
+	if err != nil {
+		return err
+	}
+	position := len(others)
+	if anchor := insertBefore + insertAfter; anchor != "" {
+		if current > 0 && isSecurityPolicyRuleInPlace(others, current, insertBefore, insertAfter) {
+			return nil
+		}
+		position = -1
+		for i, other := range others {
+			if *other.Id == anchor {
+				position = i
+				if insertAfter != "" {
+					position++
+				}
+				break
+			}
+		}
+		if position < 0 {
+			return fmt.Errorf("rule %s to place this rule next to is not found in policy %s", anchor, policyID)
+		}
+	}
+
+	previous := int64(0)
+	if position > 0 {
+		previous = *others[position-1].SequenceNumber
+	}
+	sequence := previous + standalonePolicyRuleSequenceGap
+	if position < len(others) {
+		next := *others[position].SequenceNumber
+		if next-previous < 2 {
+			return fmt.Errorf("there is no room for the rule between sequence numbers %d and %d in policy %s, please leave a gap between sequence numbers of these rules", previous, next, policyID)
+		}
+		if room := (next - previous) / 2; room < standalonePolicyRuleSequenceGap {
+			sequence = previous + room
+		}
+	}
+	rule.SequenceNumber = &sequence
 	return nil
 }
 
@@ -231,6 +343,9 @@ func resourceNsxtPolicySecurityPolicyRuleUpdate(d *schema.ResourceData, m interf
 		return policyResourceNotSupportedError()
 	}
 	rule := securityPolicyRuleSchemaToModel(d, id)
+	if err := setSecurityPolicyRulePosition(d, client, domain, policyID, &rule); err != nil {
+		return handleUpdateError("SecurityPolicyRule", fmt.Sprintf("%s/%s", policyPath, id), err)
+	}
 	err := client.Patch(domain, policyID, id, rule)
 	if err != nil {
 		return handleUpdateError("SecurityPolicyRule", fmt.Sprintf("%s/%s", policyPath, id), err)
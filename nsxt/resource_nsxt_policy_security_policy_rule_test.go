@@ -139,6 +139,42 @@ func testAccResourceNsxtPolicySecurityPolicyRuleBasic(t *testing.T, withContext
 	})
 }
 
+func TestAccResourceNsxtPolicySecurityPolicyRule_insertBefore(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_security_policy_rule.inserted"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySecurityPolicyRuleCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySecurityPolicyRuleDeps(false, "policyName", "false") +
+					testAccNsxtPolicySecurityPolicyRuleTemplate("test", name, "ALLOW", "IN", "IPV4", "10", true) +
+					testAccNsxtPolicySecurityPolicyRuleTemplate("next", name+"-next", "ALLOW", "IN", "IPV4", "20", true) +
+					testAccNsxtPolicySecurityPolicyRuleInsertBeforeTemplate(name+"-inserted"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySecurityPolicyRuleExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "insert_before", name+"-next"),
+					resource.TestCheckResourceAttrSet(testResourceName, "sequence_number"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySecurityPolicyRuleInsertBeforeTemplate(displayName string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_security_policy_rule" "inserted" {
+  display_name  = "%s"
+  policy_path   = nsxt_policy_parent_security_policy.policy1.path
+  action        = "DROP"
+  insert_before = nsxt_policy_security_policy_rule.next.nsx_id
+}`, displayName)
+}
+
 func TestAccResourceNsxtPolicySecurityPolicyRule_importBasic(t *testing.T) {
 	name := getAccTestResourceName()
 	testResourceName := "nsxt_policy_security_policy_rule.test"
@@ -0,0 +1,119 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccPreCheckServiceChain(t *testing.T) {
+	testAccPreCheckServiceInsertion(t)
+	testAccEnvDefined(t, "NSXT_TEST_PARTNER_VENDOR_TEMPLATE_NAME")
+	testAccEnvDefined(t, "NSXT_TEST_SERVICE_SEGMENT_PATH")
+}
+
+func TestAccResourceNsxtPolicyServiceChain_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_service_chain.test"
+	name := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckServiceChain(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyServiceChainCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyServiceChainTemplate(name, "ALLOW", "ANY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyServiceChainExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "service_segment_path", getTestServiceSegmentPath()),
+					resource.TestCheckResourceAttr(testResourceName, "forward_path_service_profiles.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "failure_policy", "ALLOW"),
+					resource.TestCheckResourceAttr(testResourceName, "path_selection_policy", "ANY"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyServiceChainTemplate(name, "BLOCK", "LOCAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyServiceChainExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "failure_policy", "BLOCK"),
+					resource.TestCheckResourceAttr(testResourceName, "path_selection_policy", "LOCAL"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyServiceChainExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Service Chain resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Service Chain resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyServiceChainExists(resourceID, connector, false)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Service Chain %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyServiceChainCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_service_chain" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyServiceChainExists(resourceID, connector, false)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Service Chain %s still exists", resourceID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyServiceChainTemplate(name string, failurePolicy string, pathSelectionPolicy string) string {
+	return testAccNsxtPolicyServiceProfileTemplate(name, "PUNT") + fmt.Sprintf(`
+resource "nsxt_policy_service_chain" "test" {
+  display_name                  = "%s"
+  service_segment_path          = "%s"
+  forward_path_service_profiles = [nsxt_policy_service_profile.test.path]
+  reverse_path_service_profiles = [nsxt_policy_service_profile.test.path]
+  failure_policy                = "%s"
+  path_selection_policy         = "%s"
+}`, name, getTestServiceSegmentPath(), failurePolicy, pathSelectionPolicy)
+}
@@ -0,0 +1,300 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/service_references"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyServiceProfileRedirectionActionValues = []string{
+	model.PolicyServiceProfile_REDIRECTION_ACTION_PUNT,
+	model.PolicyServiceProfile_REDIRECTION_ACTION_COPY,
+}
+
+var policyServiceProfileAttributeTypeValues = []string{
+	model.Attribute_ATTRIBUTE_TYPE_IP_ADDRESS,
+	model.Attribute_ATTRIBUTE_TYPE_PORT,
+	model.Attribute_ATTRIBUTE_TYPE_PASSWORD,
+	model.Attribute_ATTRIBUTE_TYPE_STRING,
+	model.Attribute_ATTRIBUTE_TYPE_LONG,
+	model.Attribute_ATTRIBUTE_TYPE_BOOLEAN,
+}
+
+func resourceNsxtPolicyServiceProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyServiceProfileCreate,
+		Read:   resourceNsxtPolicyServiceProfileRead,
+		Update: resourceNsxtPolicyServiceProfileUpdate,
+		Delete: resourceNsxtPolicyServiceProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyServiceProfileImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":                 getNsxIDSchema(),
+			"path":                   getPathSchema(),
+			"display_name":           getDisplayNameSchema(),
+			"description":            getDescriptionSchema(),
+			"revision":               getRevisionSchema(),
+			"tag":                    getTagsSchema(),
+			"service_reference_path": getPolicyPathSchema(true, true, "Policy path of the service reference"),
+			"vendor_template_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the vendor template defined by the partner service",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"vendor_template_key": {
+				Type:        schema.TypeString,
+				Description: "Key of the vendor template, used by the partner to identify the template",
+				Computed:    true,
+			},
+			"redirection_action": {
+				Type:         schema.TypeString,
+				Description:  "Whether traffic is redirected to the partner service or a copy is sent",
+				Optional:     true,
+				Default:      model.PolicyServiceProfile_REDIRECTION_ACTION_PUNT,
+				ValidateFunc: validation.StringInSlice(policyServiceProfileRedirectionActionValues, false),
+			},
+			"attribute": {
+				Type:        schema.TypeList,
+				Description: "Attributes overriding vendor template defaults",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Description: "Attribute key",
+							Required:    true,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "Attribute value",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Attribute display name",
+							Optional:    true,
+							Computed:    true,
+						},
+						"attribute_type": {
+							Type:         schema.TypeString,
+							Description:  "Attribute type",
+							Optional:     true,
+							Default:      model.Attribute_ATTRIBUTE_TYPE_STRING,
+							ValidateFunc: validation.StringInSlice(policyServiceProfileAttributeTypeValues, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyServiceProfileExists(serviceReferenceID string, id string, connector client.Connector) (bool, error) {
+	client := service_references.NewServiceProfilesClient(connector)
+	_, err := client.Get(serviceReferenceID, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Service Profile", err)
+}
+
+func getPolicyServiceProfileAttributesFromSchema(d *schema.ResourceData) []model.Attribute {
+	var attributes []model.Attribute
+	for _, item := range d.Get("attribute").([]interface{}) {
+		data := item.(map[string]interface{})
+		key := data["key"].(string)
+		value := data["value"].(string)
+		attributeType := data["attribute_type"].(string)
+		attribute := model.Attribute{
+			Key:           &key,
+			Value:         &value,
+			AttributeType: &attributeType,
+		}
+		if displayName := data["display_name"].(string); displayName != "" {
+			attribute.DisplayName = &displayName
+		}
+		attributes = append(attributes, attribute)
+	}
+	return attributes
+}
+
+func setPolicyServiceProfileAttributesInSchema(d *schema.ResourceData, attributes []model.Attribute) error {
+	// Password values are not returned by NSX, hence those are preserved from intent
+	intentValues := make(map[string]string)
+	for _, item := range d.Get("attribute").([]interface{}) {
+		data := item.(map[string]interface{})
+		intentValues[data["key"].(string)] = data["value"].(string)
+	}
+
+	var attributeList []map[string]interface{}
+	for _, attribute := range attributes {
+		if attribute.Key == nil {
+			continue
+		}
+		if _, ok := intentValues[*attribute.Key]; !ok {
+			// Only track attributes configured by the user
+			continue
+		}
+		elem := make(map[string]interface{})
+		elem["key"] = attribute.Key
+		elem["display_name"] = attribute.DisplayName
+		elem["attribute_type"] = attribute.AttributeType
+		if attribute.AttributeType != nil && *attribute.AttributeType == model.Attribute_ATTRIBUTE_TYPE_PASSWORD {
+			elem["value"] = intentValues[*attribute.Key]
+		} else {
+			elem["value"] = attribute.Value
+		}
+		attributeList = append(attributeList, elem)
+	}
+
+	return d.Set("attribute", attributeList)
+}
+
+func policyServiceProfilePatch(serviceReferenceID string, id string, d *schema.ResourceData, connector client.Connector) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	vendorTemplateName := d.Get("vendor_template_name").(string)
+	redirectionAction := d.Get("redirection_action").(string)
+
+	obj := model.PolicyServiceProfile{
+		DisplayName:        &displayName,
+		Description:        &description,
+		Tags:               tags,
+		VendorTemplateName: &vendorTemplateName,
+		RedirectionAction:  &redirectionAction,
+		Attributes:         getPolicyServiceProfileAttributesFromSchema(d),
+	}
+
+	client := service_references.NewServiceProfilesClient(connector)
+	return client.Patch(serviceReferenceID, id, obj)
+}
+
+func resourceNsxtPolicyServiceProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+	serviceReferenceID := getPolicyIDFromPath(d.Get("service_reference_path").(string))
+
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		id = newUUID()
+	} else {
+		exists, err := resourceNsxtPolicyServiceProfileExists(serviceReferenceID, id, connector)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Service Profile with ID '%s' already exists under Service Reference %s", id, serviceReferenceID)
+		}
+	}
+
+	log.Printf("[INFO] Creating Service Profile with ID %s", id)
+	err := policyServiceProfilePatch(serviceReferenceID, id, d, connector)
+	if err != nil {
+		return handleCreateError("Service Profile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyServiceProfileRead(d, m)
+}
+
+func resourceNsxtPolicyServiceProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Service Profile ID")
+	}
+	serviceReferenceID := getPolicyIDFromPath(d.Get("service_reference_path").(string))
+
+	client := service_references.NewServiceProfilesClient(connector)
+	obj, err := client.Get(serviceReferenceID, id)
+	if err != nil {
+		return handleReadError(d, "Service Profile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("vendor_template_name", obj.VendorTemplateName)
+	d.Set("vendor_template_key", obj.VendorTemplateKey)
+	d.Set("redirection_action", obj.RedirectionAction)
+
+	return setPolicyServiceProfileAttributesInSchema(d, obj.Attributes)
+}
+
+func resourceNsxtPolicyServiceProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Service Profile ID")
+	}
+	serviceReferenceID := getPolicyIDFromPath(d.Get("service_reference_path").(string))
+
+	log.Printf("[INFO] Updating Service Profile with ID %s", id)
+	err := policyServiceProfilePatch(serviceReferenceID, id, d, connector)
+	if err != nil {
+		return handleUpdateError("Service Profile", id, err)
+	}
+
+	return resourceNsxtPolicyServiceProfileRead(d, m)
+}
+
+func resourceNsxtPolicyServiceProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Service Profile ID")
+	}
+	serviceReferenceID := getPolicyIDFromPath(d.Get("service_reference_path").(string))
+
+	connector := getPolicyConnector(m)
+	client := service_references.NewServiceProfilesClient(connector)
+	err := client.Delete(serviceReferenceID, id)
+	if err != nil {
+		return handleDeleteError("Service Profile", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyServiceProfileImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	rd, err := nsxtPolicyPathResourceImporterHelper(d, m)
+	if err != nil {
+		return rd, fmt.Errorf("Please provide policy path of the Service Profile as an input")
+	}
+
+	serviceReferencePath, err := getParameterFromPolicyPath("", "/service-profiles/", importID)
+	if err != nil {
+		return nil, err
+	}
+	d.Set("service_reference_path", serviceReferencePath)
+
+	return rd, nil
+}
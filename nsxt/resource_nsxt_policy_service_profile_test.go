@@ -0,0 +1,115 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyServiceProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_service_profile.test"
+	name := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckServiceInsertion(t)
+			testAccEnvDefined(t, "NSXT_TEST_PARTNER_VENDOR_TEMPLATE_NAME")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyServiceProfileCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyServiceProfileTemplate(name, "PUNT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyServiceProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "vendor_template_name", getTestPartnerVendorTemplateName()),
+					resource.TestCheckResourceAttr(testResourceName, "redirection_action", "PUNT"),
+					resource.TestCheckResourceAttrSet(testResourceName, "service_reference_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyServiceProfileTemplate(name, "COPY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyServiceProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "redirection_action", "COPY"),
+				),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+				ImportStateVerifyIgnore: []string{"attribute"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyServiceProfileExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Service Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Service Profile resource ID not set in resources")
+		}
+
+		serviceReferenceID := getPolicyIDFromPath(rs.Primary.Attributes["service_reference_path"])
+		exists, err := resourceNsxtPolicyServiceProfileExists(serviceReferenceID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Service Profile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyServiceProfileCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_service_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		serviceReferenceID := getPolicyIDFromPath(rs.Primary.Attributes["service_reference_path"])
+		exists, err := resourceNsxtPolicyServiceProfileExists(serviceReferenceID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Service Profile %s still exists", resourceID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyServiceProfileTemplate(name string, redirectionAction string) string {
+	return testAccNsxtPolicyServiceReferenceTemplate(name, "") + fmt.Sprintf(`
+resource "nsxt_policy_service_profile" "test" {
+  display_name           = "%s"
+  service_reference_path = nsxt_policy_service_reference.test.path
+  vendor_template_name   = "%s"
+  redirection_action     = "%s"
+}`, name, getTestPartnerVendorTemplateName(), redirectionAction)
+}
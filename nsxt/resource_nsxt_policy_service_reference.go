@@ -0,0 +1,154 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyServiceReference() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyServiceReferenceCreate,
+		Read:   resourceNsxtPolicyServiceReferenceRead,
+		Update: resourceNsxtPolicyServiceReferenceUpdate,
+		Delete: resourceNsxtPolicyServiceReferenceDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"partner_service_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the partner service registered with NSX",
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyServiceReferenceExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewServiceReferencesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Service Reference", err)
+}
+
+func policyServiceReferencePatch(id string, d *schema.ResourceData, connector client.Connector) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	partnerServiceName := d.Get("partner_service_name").(string)
+
+	obj := model.ServiceReference{
+		DisplayName:        &displayName,
+		Description:        &description,
+		Tags:               tags,
+		PartnerServiceName: &partnerServiceName,
+	}
+
+	client := infra.NewServiceReferencesClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyServiceReferenceCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyServiceReferenceExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Service Reference with ID %s", id)
+	err = policyServiceReferencePatch(id, d, connector)
+	if err != nil {
+		return handleCreateError("Service Reference", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyServiceReferenceRead(d, m)
+}
+
+func resourceNsxtPolicyServiceReferenceRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Service Reference ID")
+	}
+
+	client := infra.NewServiceReferencesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "Service Reference", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("partner_service_name", obj.PartnerServiceName)
+
+	return nil
+}
+
+func resourceNsxtPolicyServiceReferenceUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Service Reference ID")
+	}
+
+	log.Printf("[INFO] Updating Service Reference with ID %s", id)
+	err := policyServiceReferencePatch(id, d, connector)
+	if err != nil {
+		return handleUpdateError("Service Reference", id, err)
+	}
+
+	return resourceNsxtPolicyServiceReferenceRead(d, m)
+}
+
+func resourceNsxtPolicyServiceReferenceDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Service Reference ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewServiceReferencesClient(connector)
+	err := client.Delete(id, nil)
+	if err != nil {
+		return handleDeleteError("Service Reference", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,330 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/sites"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/sites/onboarding"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"golang.org/x/exp/slices"
+)
+
+var (
+	// Default waiting setup in seconds
+	defaultSiteOnboardingCheckInterval = 30
+	defaultSiteOnboardingCheckTimeout  = 3600
+	defaultSiteOnboardingCheckDelay    = 10
+)
+
+var pendingSiteOnboardingStatus = []string{
+	gm_model.ConfigOnboardingStatus_STATUS_ALLOWED,
+	gm_model.ConfigOnboardingStatus_STATUS_IN_PROGRESS,
+}
+
+var failedSiteOnboardingStatus = []string{
+	gm_model.ConfigOnboardingStatus_STATUS_BLOCKED_FEATURE_CHECK,
+	gm_model.ConfigOnboardingStatus_STATUS_BLOCKED_CONFIG_CONFLICT_CHECK,
+	gm_model.ConfigOnboardingStatus_STATUS_BLOCKED_SITE_RESTORE_PENDING,
+	gm_model.ConfigOnboardingStatus_STATUS_BLOCKED_FULLSYNC_PENDING,
+	gm_model.ConfigOnboardingStatus_STATUS_BLOCKED_USER_REJECT,
+	gm_model.ConfigOnboardingStatus_STATUS_BLOCKED_SITE_NOT_REACHABLE,
+	gm_model.ConfigOnboardingStatus_STATUS_CONTINUE_RESOLUTION_NEEDED,
+	gm_model.ConfigOnboardingStatus_STATUS_FAILED_GM_ROLLBACK_IN_PROGRESS,
+}
+
+func resourceNsxtPolicySiteOnboarding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicySiteOnboardingCreate,
+		Read:   resourceNsxtPolicySiteOnboardingRead,
+		Delete: resourceNsxtPolicySiteOnboardingDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicySiteOnboardingImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"site_path": getPolicyPathSchema(true, true, "Policy path of the site to onboard"),
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Prefix to apply to names of conflicting site objects when promoting them to global",
+			},
+			"suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Suffix to apply to names of conflicting site objects when promoting them to global",
+			},
+			"site_backup_reference": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to site backup image taken before onboarding",
+			},
+			"fail_if_conflict": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Fail before import if site objects conflict with global objects",
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Onboarding status check timeout in seconds",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      defaultSiteOnboardingCheckTimeout,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"interval": {
+				Type:         schema.TypeInt,
+				Description:  "Interval to check onboarding status in seconds",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      defaultSiteOnboardingCheckInterval,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"delay": {
+				Type:         schema.TypeInt,
+				Description:  "Initial delay to start onboarding status checks in seconds",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      defaultSiteOnboardingCheckDelay,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Onboarding status",
+				Computed:    true,
+			},
+			"error_message": {
+				Type:        schema.TypeList,
+				Description: "Errors reported during onboarding",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"unsupported_feature": {
+				Type:        schema.TypeList,
+				Description: "Site features that are not supported on Global Manager",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func getSiteIDFromSitePath(sitePath string) (string, error) {
+	if !strings.HasPrefix(sitePath, "/global-infra/sites/") {
+		return "", fmt.Errorf("%s is not a valid site path", sitePath)
+	}
+	siteID := getPolicyIDFromPath(sitePath)
+	if siteID == "" {
+		return "", fmt.Errorf("%s is not a valid site path", sitePath)
+	}
+	return siteID, nil
+}
+
+func getSiteOnboardingStatus(connector client.Connector, siteID string) (gm_model.ConfigOnboardingStatus, error) {
+	client := onboarding.NewStatusClient(connector)
+	return client.Get(siteID)
+}
+
+func getSiteOnboardingErrors(status gm_model.ConfigOnboardingStatus) []string {
+	var errs []string
+	if status.Details == nil {
+		return errs
+	}
+	for _, e := range status.Details.ErrorMessages {
+		if e.ErrorMessage != nil {
+			errs = append(errs, *e.ErrorMessage)
+		}
+	}
+	return errs
+}
+
+func checkSiteOnboardingConflict(connector client.Connector, siteID, prefix, suffix string) error {
+	client := sites.NewOnboardingClient(connector)
+	request := gm_model.ConfigOnboardingConflictRequest{
+		SiteId: &siteID,
+	}
+	if prefix != "" {
+		request.Prefix = &prefix
+	}
+	if suffix != "" {
+		request.Suffix = &suffix
+	}
+	conflict, err := client.Checkconflict(siteID, request)
+	if err != nil {
+		return err
+	}
+	if conflict.Status == nil || *conflict.Status != gm_model.ConfigOnboardingConflictStatus_STATUS_CONFLICT_DETECTED {
+		return nil
+	}
+
+	details := ""
+	if conflict.Details != nil && conflict.Details.Path != nil {
+		details = fmt.Sprintf(" Conflicting object: %s.", *conflict.Details.Path)
+	}
+	return fmt.Errorf("objects on site %s conflict with global configuration.%s Please use nsxt_policy_site_onboarding_conflicts data source for details, or specify prefix/suffix to resolve the conflict", siteID, details)
+}
+
+func waitForSiteOnboarding(connector client.Connector, d *schema.ResourceData, siteID string) error {
+	timeout := d.Get("timeout").(int)
+	interval := d.Get("interval").(int)
+	delay := d.Get("delay").(int)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: pendingSiteOnboardingStatus,
+		Target:  []string{gm_model.ConfigOnboardingStatus_STATUS_SUCCESS},
+		Refresh: func() (interface{}, string, error) {
+			status, err := getSiteOnboardingStatus(connector, siteID)
+			if err != nil {
+				return status, "", logAPIError("Error getting site onboarding status", err)
+			}
+			if status.Status == nil {
+				return status, gm_model.ConfigOnboardingStatus_STATUS_IN_PROGRESS, nil
+			}
+			log.Printf("[DEBUG] Current onboarding status for site %s: %s", siteID, *status.Status)
+			if slices.Contains(failedSiteOnboardingStatus, *status.Status) {
+				return status, *status.Status, fmt.Errorf("onboarding of site %s failed with status %s: %s", siteID, *status.Status, strings.Join(getSiteOnboardingErrors(status), ", "))
+			}
+			return status, *status.Status, nil
+		},
+		Timeout:      time.Duration(timeout) * time.Second,
+		PollInterval: time.Duration(interval) * time.Second,
+		Delay:        time.Duration(delay) * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func resourceNsxtPolicySiteOnboardingCreate(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+
+	siteID, err := getSiteIDFromSitePath(d.Get("site_path").(string))
+	if err != nil {
+		return err
+	}
+
+	connector := getPolicyConnector(m)
+	sitesClient := global_infra.NewSitesClient(connector)
+	if _, err = sitesClient.Get(siteID); err != nil {
+		return handleCreateError("SiteOnboarding", siteID, err)
+	}
+
+	prefix := d.Get("prefix").(string)
+	suffix := d.Get("suffix").(string)
+	if d.Get("fail_if_conflict").(bool) {
+		err = checkSiteOnboardingConflict(connector, siteID, prefix, suffix)
+		if err != nil {
+			return handleCreateError("SiteOnboarding", siteID, err)
+		}
+	}
+
+	status, err := getSiteOnboardingStatus(connector, siteID)
+	if err != nil {
+		return handleCreateError("SiteOnboarding", siteID, err)
+	}
+
+	// Onboarding might have been triggered by previous apply that did not complete
+	if status.Status == nil || *status.Status != gm_model.ConfigOnboardingStatus_STATUS_SUCCESS {
+		if status.Status == nil || *status.Status != gm_model.ConfigOnboardingStatus_STATUS_IN_PROGRESS {
+			request := gm_model.ConfigOnboardingRequest{
+				SiteId: &siteID,
+			}
+			if prefix != "" {
+				request.Prefix = &prefix
+			}
+			if suffix != "" {
+				request.Suffix = &suffix
+			}
+			backupRef := d.Get("site_backup_reference").(string)
+			if backupRef != "" {
+				request.SiteBackupReference = &backupRef
+			}
+
+			log.Printf("[INFO] Starting onboarding for site %s", siteID)
+			client := sites.NewOnboardingClient(connector)
+			_, err = client.Startonboarding(siteID, request)
+			if err != nil {
+				return handleCreateError("SiteOnboarding", siteID, err)
+			}
+		}
+
+		err = waitForSiteOnboarding(connector, d, siteID)
+		if err != nil {
+			return handleCreateError("SiteOnboarding", siteID, err)
+		}
+	}
+
+	d.SetId(siteID)
+
+	return resourceNsxtPolicySiteOnboardingRead(d, m)
+}
+
+func resourceNsxtPolicySiteOnboardingRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("error obtaining SiteOnboarding ID")
+	}
+
+	status, err := getSiteOnboardingStatus(connector, id)
+	if err != nil {
+		return handleReadError(d, "SiteOnboarding", id, err)
+	}
+
+	d.Set("status", status.Status)
+	d.Set("error_message", getSiteOnboardingErrors(status))
+
+	var unsupported []string
+	for _, feature := range status.UnsupportedFeatures {
+		if feature.Name != nil {
+			unsupported = append(unsupported, *feature.Name)
+		}
+	}
+	d.Set("unsupported_feature", unsupported)
+
+	return nil
+}
+
+func resourceNsxtPolicySiteOnboardingDelete(d *schema.ResourceData, m interface{}) error {
+	// Objects promoted to Global Manager can not be returned to the site, thus
+	// destroying this resource only removes it from terraform state
+	log.Printf("[INFO] Removing onboarding of site %s from state, onboarded objects are not affected", d.Id())
+	return nil
+}
+
+func resourceNsxtPolicySiteOnboardingImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importPath := d.Id()
+	siteID, err := getSiteIDFromSitePath(importPath)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(siteID)
+	d.Set("site_path", importPath)
+	d.Set("fail_if_conflict", true)
+	d.Set("timeout", defaultSiteOnboardingCheckTimeout)
+	d.Set("interval", defaultSiteOnboardingCheckInterval)
+	d.Set("delay", defaultSiteOnboardingCheckDelay)
+
+	return []*schema.ResourceData{d}, nil
+}
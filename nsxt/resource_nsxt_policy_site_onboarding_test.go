@@ -0,0 +1,162 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Onboarding of a site can not be reverted, hence the test runs only against
+// a site explicitly designated for onboarding
+func TestAccResourceNsxtPolicySiteOnboarding_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_site_onboarding.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyGlobalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_ONBOARDING_SITE_NAME")
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySiteOnboardingTemplate(os.Getenv("NSXT_TEST_ONBOARDING_SITE_NAME")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "site_path"),
+					resource.TestCheckResourceAttr(testResourceName, "status", "SUCCESS"),
+					resource.TestCheckResourceAttr(testResourceName, "error_message.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicySiteOnboarding_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_site_onboarding.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyGlobalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_ONBOARDING_SITE_NAME")
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySiteOnboardingTemplate(os.Getenv("NSXT_TEST_ONBOARDING_SITE_NAME")),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccNsxtPolicySiteOnboardingImporterGetID,
+				ImportStateVerifyIgnore: []string{"prefix", "suffix", "site_backup_reference"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySiteOnboardingImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["nsxt_policy_site_onboarding.test"]
+	if !ok {
+		return "", fmt.Errorf("NSX Policy site onboarding resource not found in resources")
+	}
+	sitePath := rs.Primary.Attributes["site_path"]
+	if sitePath == "" {
+		return "", fmt.Errorf("NSX Policy site onboarding site_path not set in resources")
+	}
+	return sitePath, nil
+}
+
+func testAccNsxtPolicySiteOnboardingTemplate(siteName string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_site" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_site_onboarding" "test" {
+  site_path = data.nsxt_policy_site.test.path
+  prefix    = "tf-"
+}`, siteName)
+}
+
+func TestPolicySiteOnboardingCreate(t *testing.T) {
+	server, provider := testSimulatorProvider(t, "", map[string]interface{}{"global_manager": true})
+	m := provider.Meta()
+
+	objects := map[string]map[string]interface{}{
+		"/global-infra/sites/paris":                   {"resource_type": "Site"},
+		"/global-infra/sites/paris/onboarding/status": {"status": "SUCCESS", "unsupported_features": []interface{}{map[string]interface{}{"name": "IDS"}}},
+		"/global-infra/sites/rome":                    {"resource_type": "Site"},
+		"/global-infra/sites/rome/onboarding":         {"status": "CONFLICT_DETECTED", "details": map[string]interface{}{"path": "/infra/domains/default/groups/web"}},
+		"/global-infra/sites/rome/onboarding/status":  {"status": "BLOCKED_USER_REJECT", "details": map[string]interface{}{"error_messages": []interface{}{map[string]interface{}{"error_message": "rejected by admin"}}}},
+	}
+	for path, obj := range objects {
+		if err := server.Put(path, obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	res := resourceNsxtPolicySiteOnboarding()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"site_path": "/global-infra/sites/paris",
+	})
+	if err := res.Create(d, m); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "paris" || d.Get("status").(string) != "SUCCESS" {
+		t.Errorf("Unexpected onboarding state: id %s, status %s", d.Id(), d.Get("status"))
+	}
+	if unsupported := d.Get("unsupported_feature").([]interface{}); len(unsupported) != 1 || unsupported[0].(string) != "IDS" {
+		t.Errorf("Unexpected unsupported features %v", unsupported)
+	}
+
+	d = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"site_path": "/global-infra/sites/rome",
+	})
+	err := res.Create(d, m)
+	if err == nil || !strings.Contains(err.Error(), "/infra/domains/default/groups/web") {
+		t.Errorf("Expected conflict error, got %v", err)
+	}
+
+	d = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"site_path":        "/global-infra/sites/rome",
+		"fail_if_conflict": false,
+		"delay":            0,
+		"interval":         1,
+	})
+	err = res.Create(d, m)
+	if err == nil || !strings.Contains(err.Error(), "rejected by admin") {
+		t.Errorf("Expected onboarding failure, got %v", err)
+	}
+	if d.Id() != "" {
+		t.Errorf("Expected failed onboarding not to be stored in state")
+	}
+
+	d = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"site_path": "/infra/sites/default",
+	})
+	if err = res.Create(d, m); err == nil {
+		t.Errorf("Expected error for local manager site path")
+	}
+}
+
+func TestPolicySiteOnboardingLocalManager(t *testing.T) {
+	_, provider := testSimulatorProvider(t, "", nil)
+
+	res := resourceNsxtPolicySiteOnboarding()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"site_path": "/global-infra/sites/paris",
+	})
+	if err := res.Create(d, provider.Meta()); err == nil {
+		t.Errorf("Expected site onboarding to fail on local manager")
+	}
+}
@@ -125,6 +125,7 @@ func resourceNsxtPolicyTier0Gateway() *schema.Resource {
 			"edge_cluster_path":      getPolicyEdgeClusterPathSchema(),
 			"locale_service":         getPolicyLocaleServiceSchema(false),
 			"bgp_config":             getPolicyTier0BGPConfigSchema(),
+			"multicast_config":       getPolicyTier0MulticastConfigSchema(),
 			"vrf_config":             getPolicyVRFConfigSchema(),
 			"dhcp_config_path":       getPolicyPathSchema(false, false, "Policy path to DHCP server or relay configuration to use for this Tier0"),
 			"intersite_config":       getGatewayIntersiteConfigSchema(),
@@ -145,6 +146,9 @@ func resourceNsxtPolicyTier0Gateway() *schema.Resource {
 }
 
 func getPolicyTier0BGPConfigSchema() *schema.Schema {
+	bgpSchema := getPolicyBGPConfigSchema()
+	bgpSchema["ignore_route_aggregation"].ConflictsWith = []string{"bgp_config.0.route_aggregation"}
+
 	return &schema.Schema{
 		// NOTE: setting bpg_config requires a edge_cluster_path
 		Type:        schema.TypeList,
@@ -153,10 +157,39 @@ func getPolicyTier0BGPConfigSchema() *schema.Schema {
 		Computed:    true,
 		MaxItems:    1,
 		Elem: &schema.Resource{
-			Schema: getPolicyBGPConfigSchema(),
+			Schema: bgpSchema,
+		},
+	}
+}
+
+func getPolicyTier0MulticastConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		// NOTE: setting multicast_config requires a edge_cluster_path
+		Type:        schema.TypeList,
+		Description: "Multicast configuration",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:        schema.TypeBool,
+					Description: "Flag to enable multicast",
+					Optional:    true,
+					Default:     true,
+				},
+				"igmp_profile_path": getPolicyPathSchema(false, false, "Policy path to IGMP profile"),
+				"pim_profile_path":  getPolicyPathSchema(false, false, "Policy path to PIM profile"),
+				"replication_multicast_range": {
+					Type:         schema.TypeString,
+					Description:  "Multicast range used for replication, required when multicast is enabled",
+					Optional:     true,
+					ValidateFunc: validateCidr(),
+				},
+			},
 		},
 	}
 }
+
 func getPolicyBGPConfigSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"tag":      getTagsSchema(),
@@ -215,6 +248,11 @@ func getPolicyBGPConfigSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"ignore_route_aggregation": {
+			Type:        schema.TypeBool,
+			Description: "Do not manage route aggregations, preserving those configured on NSX, for example by nsxt_policy_gateway_route_aggregation resources",
+			Optional:    true,
+		},
 		"graceful_restart_mode": {
 			// BgpGracefulRestartConfig.mode
 			Type:         schema.TypeString,
@@ -374,7 +412,7 @@ func getPolicyTier0GatewayLocaleServiceWithEdgeCluster(context utl.SessionContex
 	return nil, fmt.Errorf("No locale services found for GW %v", gwID)
 }
 
-func initPolicyTier0BGPConfigMap(bgpConfig *model.BgpRoutingConfig) map[string]interface{} {
+func initPolicyTier0BGPConfigMap(bgpConfig *model.BgpRoutingConfig, ignoreRouteAggregation bool) map[string]interface{} {
 
 	cfgMap := make(map[string]interface{})
 	cfgMap["revision"] = int(*bgpConfig.Revision)
@@ -408,13 +446,16 @@ func initPolicyTier0BGPConfigMap(bgpConfig *model.BgpRoutingConfig) map[string]i
 	cfgMap["tag"] = tagList
 
 	var aggregationList []map[string]interface{}
-	for _, agg := range bgpConfig.RouteAggregations {
-		elem := make(map[string]interface{})
-		elem["prefix"] = agg.Prefix
-		elem["summary_only"] = *agg.SummaryOnly
-		aggregationList = append(aggregationList, elem)
+	if !ignoreRouteAggregation {
+		for _, agg := range bgpConfig.RouteAggregations {
+			elem := make(map[string]interface{})
+			elem["prefix"] = agg.Prefix
+			elem["summary_only"] = *agg.SummaryOnly
+			aggregationList = append(aggregationList, elem)
+		}
 	}
 	cfgMap["route_aggregation"] = aggregationList
+	cfgMap["ignore_route_aggregation"] = ignoreRouteAggregation
 
 	return cfgMap
 }
@@ -432,11 +473,77 @@ func resourceNsxtPolicyTier0GatewayReadBGPConfig(d *schema.ResourceData, connect
 		return err
 	}
 
-	data := initPolicyTier0BGPConfigMap(&bgpConfig)
+	ignoreRouteAggregation := d.Get("bgp_config.0.ignore_route_aggregation").(bool)
+	data := initPolicyTier0BGPConfigMap(&bgpConfig, ignoreRouteAggregation)
 	bgpConfigs = append(bgpConfigs, data)
 	return d.Set("bgp_config", bgpConfigs)
 }
 
+func getPolicyTier0MulticastConfigFromSchema(d *schema.ResourceData) *model.PolicyMulticastConfig {
+	id := "multicast"
+	resourceType := "PolicyMulticastConfig"
+	enabled := false
+	config := model.PolicyMulticastConfig{
+		Id:           &id,
+		ResourceType: &resourceType,
+		Enabled:      &enabled,
+	}
+
+	multicastConfigs := d.Get("multicast_config").([]interface{})
+	if len(multicastConfigs) == 0 || multicastConfigs[0] == nil {
+		if !d.HasChange("multicast_config") {
+			return nil
+		}
+		// Multicast config can not be deleted, disable it instead
+		return &config
+	}
+
+	cfgMap := multicastConfigs[0].(map[string]interface{})
+	enabled = cfgMap["enabled"].(bool)
+	igmpProfilePath := cfgMap["igmp_profile_path"].(string)
+	pimProfilePath := cfgMap["pim_profile_path"].(string)
+	replicationRange := cfgMap["replication_multicast_range"].(string)
+	if len(igmpProfilePath) > 0 {
+		config.IgmpProfilePath = &igmpProfilePath
+	}
+	if len(pimProfilePath) > 0 {
+		config.PimProfilePath = &pimProfilePath
+	}
+	if len(replicationRange) > 0 {
+		config.ReplicationMulticastRange = &replicationRange
+	}
+
+	return &config
+}
+
+func resourceNsxtPolicyTier0GatewayReadMulticastConfig(d *schema.ResourceData, connector client.Connector, localeService model.LocaleServices) error {
+	var multicastConfigs []map[string]interface{}
+	client := locale_services.NewMulticastClient(connector)
+
+	multicastConfig, err := client.Get(d.Id(), *localeService.Id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return d.Set("multicast_config", multicastConfigs)
+		}
+		return err
+	}
+
+	_, isSet := d.GetOk("multicast_config")
+	if !isSet && (multicastConfig.Enabled == nil || !*multicastConfig.Enabled) {
+		// Multicast is disabled by default, avoid diff for configurations
+		// that do not specify multicast
+		return d.Set("multicast_config", multicastConfigs)
+	}
+
+	cfgMap := make(map[string]interface{})
+	cfgMap["enabled"] = multicastConfig.Enabled
+	cfgMap["igmp_profile_path"] = multicastConfig.IgmpProfilePath
+	cfgMap["pim_profile_path"] = multicastConfig.PimProfilePath
+	cfgMap["replication_multicast_range"] = multicastConfig.ReplicationMulticastRange
+	multicastConfigs = append(multicastConfigs, cfgMap)
+	return d.Set("multicast_config", multicastConfigs)
+}
+
 func getPolicyVRFConfigFromSchema(d *schema.ResourceData) *model.Tier0VrfConfig {
 
 	if util.NsxVersionLower("3.0.0") {
@@ -694,9 +801,19 @@ func verifyPolicyTier0GatewayConfig(d *schema.ResourceData, isGlobalManager bool
 		if !isSetLocaleService {
 			return fmt.Errorf("locale_service setting is mandatory with NSX Global Manager")
 		}
+
+		_, isSet = d.GetOk("multicast_config")
+		if isSet {
+			return fmt.Errorf("multicast_config configuration is only supported with NSX Local Manager")
+		}
 		return nil
 	}
 
+	_, isSetMulticast := d.GetOk("multicast_config")
+	if isSetMulticast && d.Get("edge_cluster_path").(string) == "" {
+		return fmt.Errorf("A valid edge_cluster_path is required when multicast_config is set")
+	}
+
 	return nil
 }
 
@@ -714,6 +831,20 @@ func initPolicyTier0ChildBgpConfig(config *model.BgpRoutingConfig) (*data.Struct
 	return dataValue.(*data.StructValue), nil
 }
 
+func initPolicyTier0ChildMulticastConfig(config *model.PolicyMulticastConfig) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+	childConfig := model.ChildPolicyMulticastConfig{
+		ResourceType:          "ChildPolicyMulticastConfig",
+		PolicyMulticastConfig: config,
+	}
+	dataValue, errors := converter.ConvertToVapi(childConfig, model.ChildPolicyMulticastConfigBindingType())
+	if errors != nil {
+		return nil, fmt.Errorf("Error converting child Multicast Configuration: %v", errors[0])
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
 func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *schema.ResourceData, connector client.Connector, id string) (model.Infra, error) {
 	var infraChildren, gwChildren, lsChildren []*data.StructValue
 	var infraStruct model.Infra
@@ -794,6 +925,15 @@ func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 		lsChildren = append(lsChildren, structValue)
 	}
 
+	multicastConfig := getPolicyTier0MulticastConfigFromSchema(d)
+	if multicastConfig != nil && !isGlobalManager {
+		structValue, err := initPolicyTier0ChildMulticastConfig(multicastConfig)
+		if err != nil {
+			return infraStruct, err
+		}
+		lsChildren = append(lsChildren, structValue)
+	}
+
 	edgeClusterPath := d.Get("edge_cluster_path").(string)
 	_, redistributionSet := d.GetOk("redistribution_config")
 	// The user can either define locale_service (GL or LM) or edge_cluster_path (LM only)
@@ -990,6 +1130,11 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 						return handleReadError(d, "BGP Configuration for T0", id, err)
 					}
 
+					err = resourceNsxtPolicyTier0GatewayReadMulticastConfig(d, connector, service)
+					if err != nil {
+						return handleReadError(d, "Multicast Configuration for T0", id, err)
+					}
+
 					redistributionConfigs := getLocaleServiceRedistributionConfig(&localeServices[i])
 					if d.Get("redistribution_set").(bool) {
 						d.Set("redistribution_config", redistributionConfigs)
@@ -1005,6 +1150,7 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 	} else {
 		// set empty bgp_config to keep empty plan
 		d.Set("bgp_config", make([]map[string]interface{}, 0))
+		d.Set("multicast_config", make([]map[string]interface{}, 0))
 	}
 
 	if shouldSetLS {
@@ -429,6 +429,40 @@ func TestAccResourceNsxtPolicyTier0Gateway_createWithBGP(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyTier0Gateway_multicast(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tier0_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTier0CheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier0WithMulticastTemplate(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier0Exists(testResourceName),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_cluster_path"),
+					resource.TestCheckResourceAttr(testResourceName, "multicast_config.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "multicast_config.0.enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "multicast_config.0.replication_multicast_range", "233.1.0.0/16"),
+					resource.TestCheckResourceAttrSet(testResourceName, "multicast_config.0.pim_profile_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "multicast_config.0.igmp_profile_path"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTier0WithMulticastTemplate(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier0Exists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "multicast_config.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 // TODO: add route_distinguisher when VNI pool DS is exposed
 func TestAccResourceNsxtPolicyTier0Gateway_withVRF(t *testing.T) {
 	name := getAccTestResourceName()
@@ -1000,3 +1034,33 @@ data "nsxt_policy_realization_info" "realization_info" {
   path = nsxt_policy_tier0_gateway.test.path
 }`, name)
 }
+
+func testAccNsxtPolicyTier0WithMulticastTemplate(name string, withMulticast bool) string {
+	multicastConfig := ""
+	if withMulticast {
+		multicastConfig = `
+  multicast_config {
+    igmp_profile_path           = nsxt_policy_igmp_profile.test.path
+    pim_profile_path            = nsxt_policy_pim_profile.test.path
+    replication_multicast_range = "233.1.0.0/16"
+  }`
+	}
+	return fmt.Sprintf(`
+data "nsxt_policy_edge_cluster" "EC" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_pim_profile" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_igmp_profile" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_tier0_gateway" "test" {
+  display_name      = "%s"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.EC.path
+%s
+}`, getEdgeClusterName(), name, name, name, multicastConfig)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	t1_locale_service "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/locale_services"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 )
 
@@ -144,7 +145,28 @@ func resourceNsxtPolicyTier1Gateway() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(t1TypeValues, false),
 				Optional:     true,
 			},
-			"context": getContextSchema(false, false, false),
+			"multicast_config": getPolicyTier1MulticastConfigSchema(),
+			"context":          getContextSchema(false, false, false),
+		},
+	}
+}
+
+func getPolicyTier1MulticastConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		// NOTE: setting multicast_config requires a edge_cluster_path
+		Type:        schema.TypeList,
+		Description: "Multicast configuration",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:        schema.TypeBool,
+					Description: "Flag to enable multicast",
+					Optional:    true,
+					Default:     true,
+				},
+			},
 		},
 	}
 }
@@ -340,7 +362,81 @@ func resourceNsxtPolicyTier1GatewaySetVersionDependentAttrs(d *schema.ResourceDa
 
 }
 
-func initSingleTier1GatewayLocaleService(context utl.SessionContext, d *schema.ResourceData, connector client.Connector) (*data.StructValue, error) {
+func getPolicyTier1MulticastConfigFromSchema(d *schema.ResourceData) *model.PolicyTier1MulticastConfig {
+	id := "multicast"
+	resourceType := "PolicyTier1MulticastConfig"
+	enabled := false
+	config := model.PolicyTier1MulticastConfig{
+		Id:           &id,
+		ResourceType: &resourceType,
+		Enabled:      &enabled,
+	}
+
+	multicastConfigs := d.Get("multicast_config").([]interface{})
+	if len(multicastConfigs) > 0 && multicastConfigs[0] != nil {
+		cfgMap := multicastConfigs[0].(map[string]interface{})
+		enabled = cfgMap["enabled"].(bool)
+	}
+	// Multicast config can not be deleted, it is disabled instead
+
+	return &config
+}
+
+func initPolicyTier1ChildMulticastConfig(config *model.PolicyTier1MulticastConfig) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+	childConfig := model.ChildPolicyTier1MulticastConfig{
+		ResourceType:               "ChildPolicyTier1MulticastConfig",
+		PolicyTier1MulticastConfig: config,
+	}
+	dataValue, errors := converter.ConvertToVapi(childConfig, model.ChildPolicyTier1MulticastConfigBindingType())
+	if errors != nil {
+		return nil, fmt.Errorf("Error converting child Multicast Configuration: %v", errors[0])
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func resourceNsxtPolicyTier1GatewayReadMulticastConfig(d *schema.ResourceData, connector client.Connector, localeService model.LocaleServices) error {
+	var multicastConfigs []map[string]interface{}
+	client := t1_locale_service.NewMulticastClient(connector)
+
+	multicastConfig, err := client.Get(d.Id(), *localeService.Id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return d.Set("multicast_config", multicastConfigs)
+		}
+		return err
+	}
+
+	_, isSet := d.GetOk("multicast_config")
+	if !isSet && (multicastConfig.Enabled == nil || !*multicastConfig.Enabled) {
+		// Multicast is disabled by default, avoid diff for configurations
+		// that do not specify multicast
+		return d.Set("multicast_config", multicastConfigs)
+	}
+
+	cfgMap := make(map[string]interface{})
+	cfgMap["enabled"] = multicastConfig.Enabled
+	multicastConfigs = append(multicastConfigs, cfgMap)
+	return d.Set("multicast_config", multicastConfigs)
+}
+
+func validateTier1MulticastConfig(context utl.SessionContext, d *schema.ResourceData) error {
+	_, isSet := d.GetOk("multicast_config")
+	if !isSet {
+		return nil
+	}
+	if context.ClientType != utl.Local {
+		return fmt.Errorf("multicast_config configuration is only supported with NSX Local Manager")
+	}
+	if d.Get("edge_cluster_path").(string) == "" {
+		return fmt.Errorf("A valid edge_cluster_path is required when multicast_config is set")
+	}
+
+	return nil
+}
+
+func initSingleTier1GatewayLocaleService(context utl.SessionContext, d *schema.ResourceData, children []*data.StructValue, connector client.Connector) (*data.StructValue, error) {
 
 	edgeClusterPath := d.Get("edge_cluster_path").(string)
 	var serviceStruct *model.LocaleServices
@@ -365,6 +461,9 @@ func initSingleTier1GatewayLocaleService(context utl.SessionContext, d *schema.R
 	} else {
 		serviceStruct.EdgeClusterPath = nil
 	}
+	if len(children) > 0 {
+		serviceStruct.Children = children
+	}
 
 	log.Printf("[DEBUG] Using Locale Service with ID %s and Edge Cluster %v", *serviceStruct.Id, serviceStruct.EdgeClusterPath)
 	return initChildLocaleService(serviceStruct, false)
@@ -392,8 +491,8 @@ func policyTier1GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 	connectivityType := d.Get("type").(string)
 	revision := int64(d.Get("revision").(int))
 
-	if haMode == model.Tier1_HA_MODE_ACTIVE && util.NsxVersionLower("4.0.0") {
-		return infraStruct, fmt.Errorf("ACTIVE_ACTIVE HA mode is not supported in NSX versions lower than 4.0.0. Use ACTIVE_BACKUP instead")
+	if err := validateTier1MulticastConfig(context, d); err != nil {
+		return infraStruct, err
 	}
 
 	t1Type := "Tier1"
@@ -444,9 +543,18 @@ func policyTier1GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 		obj.IntersiteConfig = intersiteConfig
 	}
 
+	var lsChildren []*data.StructValue
+	if d.HasChange("multicast_config") && context.ClientType == utl.Local {
+		structValue, err := initPolicyTier1ChildMulticastConfig(getPolicyTier1MulticastConfigFromSchema(d))
+		if err != nil {
+			return infraStruct, err
+		}
+		lsChildren = append(lsChildren, structValue)
+	}
+
 	// set edge cluster for local manager if needed
-	if d.HasChange("edge_cluster_path") && context.ClientType != utl.Global {
-		dataValue, err := initSingleTier1GatewayLocaleService(context, d, connector)
+	if (d.HasChange("edge_cluster_path") || len(lsChildren) > 0) && context.ClientType != utl.Global {
+		dataValue, err := initSingleTier1GatewayLocaleService(context, d, lsChildren, connector)
 		if err != nil {
 			return infraStruct, err
 		}
@@ -627,6 +735,12 @@ func resourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) e
 			} else {
 				if service.EdgeClusterPath != nil {
 					d.Set("edge_cluster_path", service.EdgeClusterPath)
+					if context.ClientType == utl.Local && util.NsxVersionHigherOrEqual("4.1.1") {
+						err = resourceNsxtPolicyTier1GatewayReadMulticastConfig(d, connector, service)
+						if err != nil {
+							return handleReadError(d, "Multicast Configuration for T1", id, err)
+						}
+					}
 				}
 			}
 		}
@@ -0,0 +1,480 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/serviceinsertion/services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/serviceinsertion/services/service_deployments"
+)
+
+var serviceDeploymentTypeValues = []string{
+	model.ServiceDeployment_DEPLOYMENT_TYPE_HOSTLOCAL,
+	model.ServiceDeployment_DEPLOYMENT_TYPE_CLUSTERED,
+}
+
+var serviceDeploymentIPAllocationTypeValues = []string{
+	model.NicInfo_IP_ALLOCATION_TYPE_DHCP,
+	model.NicInfo_IP_ALLOCATION_TYPE_STATIC,
+	model.NicInfo_IP_ALLOCATION_TYPE_NONE,
+}
+
+var serviceDeploymentAttributeTypeValues = []string{
+	model.Attribute_ATTRIBUTE_TYPE_IP_ADDRESS,
+	model.Attribute_ATTRIBUTE_TYPE_PORT,
+	model.Attribute_ATTRIBUTE_TYPE_PASSWORD,
+	model.Attribute_ATTRIBUTE_TYPE_STRING,
+	model.Attribute_ATTRIBUTE_TYPE_LONG,
+	model.Attribute_ATTRIBUTE_TYPE_BOOLEAN,
+}
+
+func resourceNsxtServiceDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtServiceDeploymentCreate,
+		Read:   resourceNsxtServiceDeploymentRead,
+		Update: resourceNsxtServiceDeploymentUpdate,
+		Delete: resourceNsxtServiceDeploymentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtServiceDeploymentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision":     getRevisionSchema(),
+			"description":  getDescriptionSchema(),
+			"display_name": getDisplayNameSchema(),
+			"tag":          getTagsSchema(),
+			"service_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the registered service to deploy",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"compute_manager_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the compute manager the cluster is registered with",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"compute_collection_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the compute collection representing the host cluster",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"storage_id": {
+				Type:        schema.TypeString,
+				Description: "Moref of the datastore to deploy service VMs on",
+				Required:    true,
+			},
+			"deployment_spec_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the deployment spec of the service, changing it upgrades the service VMs",
+				Required:    true,
+			},
+			"deployment_template_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the deployment template of the service",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"deployment_type": {
+				Type:         schema.TypeString,
+				Description:  "Whether service VMs are deployed on every host or as a cluster",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      model.ServiceDeployment_DEPLOYMENT_TYPE_HOSTLOCAL,
+				ValidateFunc: validation.StringInSlice(serviceDeploymentTypeValues, false),
+			},
+			"management_network": {
+				Type:        schema.TypeList,
+				Description: "Management network configuration of service VMs",
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_id": {
+							Type:        schema.TypeString,
+							Description: "Moref or logical switch ID of the management network",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"ip_allocation_type": {
+							Type:         schema.TypeString,
+							Description:  "IP allocation type of management interface",
+							Optional:     true,
+							ForceNew:     true,
+							Default:      model.NicInfo_IP_ALLOCATION_TYPE_DHCP,
+							ValidateFunc: validation.StringInSlice(serviceDeploymentIPAllocationTypeValues, false),
+						},
+						"ip_pool_id": {
+							Type:        schema.TypeString,
+							Description: "ID of IP pool to allocate management addresses from",
+							Optional:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+			"attribute": {
+				Type:        schema.TypeList,
+				Description: "Deployment template attributes passed to the service VMs",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Description: "Attribute key",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "Attribute value",
+							Required:    true,
+							ForceNew:    true,
+							Sensitive:   true,
+						},
+						"attribute_type": {
+							Type:         schema.TypeString,
+							Description:  "Attribute type",
+							Optional:     true,
+							ForceNew:     true,
+							Default:      model.Attribute_ATTRIBUTE_TYPE_STRING,
+							ValidateFunc: validation.StringInSlice(serviceDeploymentAttributeTypeValues, false),
+						},
+					},
+				},
+			},
+			"deployment_status": {
+				Type:        schema.TypeString,
+				Description: "Deployment status of service VMs on the cluster",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func getServiceDeploymentAttributesFromSchema(d *schema.ResourceData) []model.Attribute {
+	var attributes []model.Attribute
+	for _, attr := range d.Get("attribute").([]interface{}) {
+		data := attr.(map[string]interface{})
+		key := data["key"].(string)
+		value := data["value"].(string)
+		attrType := data["attribute_type"].(string)
+		attributes = append(attributes, model.Attribute{
+			Key:           &key,
+			Value:         &value,
+			AttributeType: &attrType,
+		})
+	}
+
+	return attributes
+}
+
+func getServiceDeploymentNicInfoFromSchema(d *schema.ResourceData) *model.VmNicInfo {
+	networks := d.Get("management_network").([]interface{})
+	if len(networks) == 0 || networks[0] == nil {
+		return nil
+	}
+
+	data := networks[0].(map[string]interface{})
+	networkID := data["network_id"].(string)
+	allocationType := data["ip_allocation_type"].(string)
+	interfaceType := model.NicMetadata_INTERFACE_TYPE_MANAGEMENT
+	nicInfo := model.NicInfo{
+		NetworkId:        &networkID,
+		IpAllocationType: &allocationType,
+		NicMetadata: &model.NicMetadata{
+			InterfaceType: &interfaceType,
+		},
+	}
+	ipPoolID := data["ip_pool_id"].(string)
+	if ipPoolID != "" {
+		nicInfo.IpPoolId = &ipPoolID
+	}
+
+	return &model.VmNicInfo{
+		NicInfos: []model.NicInfo{nicInfo},
+	}
+}
+
+func setServiceDeploymentNicInfoInSchema(d *schema.ResourceData, nicInfo *model.VmNicInfo) {
+	if nicInfo == nil {
+		return
+	}
+
+	var networks []map[string]interface{}
+	for _, nic := range nicInfo.NicInfos {
+		if nic.NicMetadata != nil && nic.NicMetadata.InterfaceType != nil && *nic.NicMetadata.InterfaceType != model.NicMetadata_INTERFACE_TYPE_MANAGEMENT {
+			continue
+		}
+		elem := make(map[string]interface{})
+		elem["network_id"] = nic.NetworkId
+		elem["ip_allocation_type"] = nic.IpAllocationType
+		elem["ip_pool_id"] = nic.IpPoolId
+		networks = append(networks, elem)
+	}
+	d.Set("management_network", networks)
+}
+
+func getServiceDeploymentPerimeter(deploymentType string) string {
+	if deploymentType == model.ServiceDeployment_DEPLOYMENT_TYPE_CLUSTERED {
+		return model.ServiceDeployment_PERIMETER_CLUSTER
+	}
+	return model.ServiceDeployment_PERIMETER_HOST
+}
+
+func getServiceDeploymentStateConf(connector client.Connector, serviceID string, id string, pending []string, target []string) *resource.StateChangeConf {
+	return &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: func() (interface{}, string, error) {
+			client := service_deployments.NewStatusClient(connector)
+			status, err := client.Get(serviceID, id, nil)
+			if isNotFoundError(err) {
+				return status, model.ServiceDeploymentStatus_DEPLOYMENT_STATUS_UNDEPLOYMENT_SUCCESSFUL, nil
+			}
+			if err != nil {
+				log.Printf("[DEBUG]: NSX Failed to retrieve Service Deployment status: %v", err)
+				return nil, "", err
+			}
+			if status.DeploymentStatus == nil {
+				return status, model.ServiceDeploymentStatus_DEPLOYMENT_STATUS_DEPLOYMENT_QUEUED, nil
+			}
+
+			deploymentStatus := *status.DeploymentStatus
+			if strings.HasSuffix(deploymentStatus, "_FAILED") {
+				var issues []string
+				for _, issue := range status.DeploymentIssues {
+					if issue.IssueDescription != nil {
+						issues = append(issues, *issue.IssueDescription)
+					}
+				}
+				return status, deploymentStatus, fmt.Errorf("Service Deployment %s is in state %s: %s", id, deploymentStatus, strings.Join(issues, ", "))
+			}
+
+			return status, deploymentStatus, nil
+		},
+		Delay:        time.Duration(5) * time.Second,
+		Timeout:      time.Duration(3600) * time.Second,
+		PollInterval: time.Duration(10) * time.Second,
+	}
+}
+
+func resourceNsxtServiceDeploymentCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := services.NewServiceDeploymentsClient(connector)
+
+	serviceID := d.Get("service_id").(string)
+	description := d.Get("description").(string)
+	displayName := d.Get("display_name").(string)
+	tags := getMPTagsFromSchema(d)
+	computeManagerID := d.Get("compute_manager_id").(string)
+	computeCollectionID := d.Get("compute_collection_id").(string)
+	storageID := d.Get("storage_id").(string)
+	deploymentSpecName := d.Get("deployment_spec_name").(string)
+	templateName := d.Get("deployment_template_name").(string)
+	deploymentType := d.Get("deployment_type").(string)
+	perimeter := getServiceDeploymentPerimeter(deploymentType)
+	targetType := "ComputeCollection"
+
+	obj := model.ServiceDeployment{
+		Description:        &description,
+		DisplayName:        &displayName,
+		Tags:               tags,
+		ServiceId:          &serviceID,
+		DeploymentSpecName: &deploymentSpecName,
+		DeploymentType:     &deploymentType,
+		Perimeter:          &perimeter,
+		DeployedTo: []model.ResourceReference{
+			{
+				TargetId:   &computeCollectionID,
+				TargetType: &targetType,
+			},
+		},
+		InstanceDeploymentTemplate: &model.DeploymentTemplate{
+			Name:       &templateName,
+			Attributes: getServiceDeploymentAttributesFromSchema(d),
+		},
+		ServiceDeploymentConfig: &model.ServiceDeploymentConfig{
+			ComputeManagerId:    &computeManagerID,
+			ComputeCollectionId: &computeCollectionID,
+			StorageId:           &storageID,
+			VmNicInfo:           getServiceDeploymentNicInfoFromSchema(d),
+		},
+	}
+
+	log.Printf("[INFO] Creating Service Deployment %s", displayName)
+	obj, err := client.Create(serviceID, obj)
+	if err != nil {
+		return handleCreateError("Service Deployment", displayName, err)
+	}
+
+	id := *obj.Id
+	d.SetId(id)
+
+	pending := []string{
+		model.ServiceDeploymentStatus_DEPLOYMENT_STATUS_DEPLOYMENT_QUEUED,
+		model.ServiceDeploymentStatus_DEPLOYMENT_STATUS_DEPLOYMENT_IN_PROGRESS,
+	}
+	target := []string{model.ServiceDeploymentStatus_DEPLOYMENT_STATUS_DEPLOYMENT_SUCCESSFUL}
+	_, err = getServiceDeploymentStateConf(connector, serviceID, id, pending, target).WaitForState()
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtServiceDeploymentRead(d, m)
+}
+
+func resourceNsxtServiceDeploymentRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("error obtaining logical object id")
+	}
+
+	serviceID := d.Get("service_id").(string)
+	client := services.NewServiceDeploymentsClient(connector)
+	obj, err := client.Get(serviceID, id)
+	if err != nil {
+		return handleReadError(d, "Service Deployment", id, err)
+	}
+
+	d.Set("revision", obj.Revision)
+	d.Set("description", obj.Description)
+	d.Set("display_name", obj.DisplayName)
+	setMPTagsInSchema(d, obj.Tags)
+	d.Set("deployment_spec_name", obj.DeploymentSpecName)
+	d.Set("deployment_type", obj.DeploymentType)
+	if obj.InstanceDeploymentTemplate != nil {
+		d.Set("deployment_template_name", obj.InstanceDeploymentTemplate.Name)
+	}
+	if obj.ServiceDeploymentConfig != nil {
+		d.Set("compute_manager_id", obj.ServiceDeploymentConfig.ComputeManagerId)
+		d.Set("compute_collection_id", obj.ServiceDeploymentConfig.ComputeCollectionId)
+		d.Set("storage_id", obj.ServiceDeploymentConfig.StorageId)
+		setServiceDeploymentNicInfoInSchema(d, obj.ServiceDeploymentConfig.VmNicInfo)
+	}
+	// Attribute values are not reliably returned by NSX, hence attributes are
+	// preserved from intent
+
+	statusClient := service_deployments.NewStatusClient(connector)
+	status, err := statusClient.Get(serviceID, id, nil)
+	if err != nil {
+		log.Printf("[WARNING] Failed to retrieve status for Service Deployment %s: %v", id, err)
+	} else {
+		d.Set("deployment_status", status.DeploymentStatus)
+	}
+
+	return nil
+}
+
+func resourceNsxtServiceDeploymentUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("error obtaining logical object id")
+	}
+
+	serviceID := d.Get("service_id").(string)
+	client := services.NewServiceDeploymentsClient(connector)
+
+	if d.HasChange("deployment_spec_name") || d.HasChange("storage_id") {
+		deploymentSpecName := d.Get("deployment_spec_name").(string)
+		storageID := d.Get("storage_id").(string)
+		spec := model.DeploymentSpecName{
+			DeploymentSpecName: &deploymentSpecName,
+			StorageId:          &storageID,
+		}
+		log.Printf("[INFO] Upgrading Service Deployment %s to %s", id, deploymentSpecName)
+		err := client.Upgrade(serviceID, id, spec)
+		if err != nil {
+			return handleUpdateError("Service Deployment", id, err)
+		}
+
+		pending := []string{
+			model.ServiceDeploymentStatus_DEPLOYMENT_STATUS_UPGRADE_QUEUED,
+			model.ServiceDeploymentStatus_DEPLOYMENT_STATUS_UPGRADE_IN_PROGRESS,
+		}
+		target := []string{model.ServiceDeploymentStatus_DEPLOYMENT_STATUS_DEPLOYMENT_SUCCESSFUL}
+		_, err = getServiceDeploymentStateConf(connector, serviceID, id, pending, target).WaitForState()
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChanges("display_name", "description", "tag") {
+		obj, err := client.Get(serviceID, id)
+		if err != nil {
+			return handleUpdateError("Service Deployment", id, err)
+		}
+
+		description := d.Get("description").(string)
+		displayName := d.Get("display_name").(string)
+		obj.Description = &description
+		obj.DisplayName = &displayName
+		obj.Tags = getMPTagsFromSchema(d)
+		if obj.InstanceDeploymentTemplate != nil {
+			obj.InstanceDeploymentTemplate.Attributes = getServiceDeploymentAttributesFromSchema(d)
+		}
+
+		_, err = client.Update(serviceID, id, obj)
+		if err != nil {
+			return handleUpdateError("Service Deployment", id, err)
+		}
+	}
+
+	return resourceNsxtServiceDeploymentRead(d, m)
+}
+
+func resourceNsxtServiceDeploymentDelete(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("error obtaining logical object id")
+	}
+
+	serviceID := d.Get("service_id").(string)
+	client := services.NewServiceDeploymentsClient(connector)
+	err := client.Delete(serviceID, id, nil)
+	if err != nil {
+		return handleDeleteError("Service Deployment", id, err)
+	}
+
+	pending := []string{
+		model.ServiceDeploymentStatus_DEPLOYMENT_STATUS_DEPLOYMENT_SUCCESSFUL,
+		model.ServiceDeploymentStatus_DEPLOYMENT_STATUS_UNDEPLOYMENT_QUEUED,
+		model.ServiceDeploymentStatus_DEPLOYMENT_STATUS_UNDEPLOYMENT_IN_PROGRESS,
+	}
+	target := []string{model.ServiceDeploymentStatus_DEPLOYMENT_STATUS_UNDEPLOYMENT_SUCCESSFUL}
+	_, err = getServiceDeploymentStateConf(connector, serviceID, id, pending, target).WaitForState()
+	return err
+}
+
+func resourceNsxtServiceDeploymentImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	s := strings.Split(importID, "/")
+	if len(s) != 2 || isSpaceString(s[0]) || isSpaceString(s[1]) {
+		return []*schema.ResourceData{d}, fmt.Errorf("Please provide <service-id>/<deployment-id> as an input")
+	}
+
+	d.Set("service_id", s[0])
+	d.SetId(s[1])
+
+	return []*schema.ResourceData{d}, nil
+}
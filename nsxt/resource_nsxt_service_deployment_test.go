@@ -0,0 +1,138 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/serviceinsertion/services"
+)
+
+func TestAccResourceNsxtServiceDeployment_basic(t *testing.T) {
+	testResourceName := "nsxt_service_deployment.test"
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_COMPUTE_MANAGER")
+			testAccEnvDefined(t, "NSXT_TEST_COMPUTE_COLLECTION")
+			testAccEnvDefined(t, "NSXT_TEST_DEPLOYMENT_SERVICE_ID")
+			testAccEnvDefined(t, "NSXT_TEST_DEPLOYMENT_SPEC_NAME")
+			testAccEnvDefined(t, "NSXT_TEST_DEPLOYMENT_TEMPLATE_NAME")
+			testAccEnvDefined(t, "NSXT_TEST_DATASTORE_ID")
+			testAccEnvDefined(t, "NSXT_TEST_MANAGEMENT_NETWORK_ID")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtServiceDeploymentCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtServiceDeploymentTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtServiceDeploymentExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "deployment_type", "HOSTLOCAL"),
+					resource.TestCheckResourceAttr(testResourceName, "deployment_status", "DEPLOYMENT_SUCCESSFUL"),
+					resource.TestCheckResourceAttr(testResourceName, "management_network.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtServiceDeploymentTemplate(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtServiceDeploymentExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNsxtServiceDeploymentImporterGetID,
+			},
+		},
+	})
+}
+
+func testAccNsxtServiceDeploymentImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["nsxt_service_deployment.test"]
+	if !ok {
+		return "", fmt.Errorf("Service Deployment resource not found in resources")
+	}
+	return fmt.Sprintf("%s/%s", rs.Primary.Attributes["service_id"], rs.Primary.ID), nil
+}
+
+func testAccNsxtServiceDeploymentExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+		client := services.NewServiceDeploymentsClient(connector)
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Service Deployment resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Service Deployment resource ID not set in resources")
+		}
+
+		_, err := client.Get(rs.Primary.Attributes["service_id"], resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Service Deployment %s: %v", resourceID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtServiceDeploymentCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	client := services.NewServiceDeploymentsClient(connector)
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_service_deployment" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		_, err := client.Get(rs.Primary.Attributes["service_id"], resourceID)
+		if err == nil {
+			return fmt.Errorf("Service Deployment %s still exists", resourceID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtServiceDeploymentTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_compute_manager" "test" {
+  display_name = "%s"
+}
+
+data "nsxt_compute_collection" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_service_deployment" "test" {
+  display_name             = "%s"
+  service_id               = "%s"
+  compute_manager_id       = data.nsxt_compute_manager.test.id
+  compute_collection_id    = data.nsxt_compute_collection.test.id
+  storage_id               = "%s"
+  deployment_spec_name     = "%s"
+  deployment_template_name = "%s"
+
+  management_network {
+    network_id = "%s"
+  }
+}`, getComputeManagerName(), getComputeCollectionName(), name, getTestDeploymentServiceID(), getTestDatastoreID(),
+		getTestDeploymentSpecName(), getTestDeploymentTemplateName(), getTestManagementNetworkID())
+}
@@ -4,7 +4,10 @@
 package nsxt
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"hash/crc32"
 	"log"
 	"time"
 
@@ -125,6 +128,8 @@ func resourceNsxtUpgradeRun() *schema.Resource {
 		Update: resourceNsxtUpgradeRunUpdate,
 		Delete: resourceNsxtUpgradeRunDelete,
 
+		CustomizeDiff: resourceNsxtUpgradeRunCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"upgrade_prepare_ready_id": {
 				Type:        schema.TypeString,
@@ -172,7 +177,42 @@ func resourceNsxtUpgradeRun() *schema.Resource {
 				Default:      defaultUpgradeStatusCheckDelay,
 				ValidateFunc: validation.IntAtLeast(0),
 			},
+			"paused": {
+				Type:        schema.TypeBool,
+				Description: "Pause the upgrade. Setting it back to false resumes the upgrade",
+				Optional:    true,
+				Default:     false,
+			},
 			"upgrade_group_plan": getUpgradeGroupPlanSchema(),
+			"progress": {
+				Type:        schema.TypeList,
+				Description: "Upgrade progress checkpoint of each component",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component": {
+							Type:        schema.TypeString,
+							Description: "Component type",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Last known upgrade status of component",
+							Computed:    true,
+						},
+						"plan_checksum": {
+							Type:        schema.TypeString,
+							Description: "Checksum of the group and setting configuration applied to component",
+							Computed:    true,
+						},
+						"completed": {
+							Type:        schema.TypeBool,
+							Description: "Whether upgrade of component has been driven to completion",
+							Computed:    true,
+						},
+					},
+				},
+			},
 			"state": {
 				Type:        schema.TypeList,
 				Description: "Upgrade states",
@@ -397,7 +437,8 @@ func resourceNsxtUpgradeRunCreate(d *schema.ResourceData, m interface{}) error {
 
 func upgradeRunCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
 	id := d.Id()
-	if id == "" {
+	isCreate := id == ""
+	if isCreate {
 		id = newUUID()
 	}
 
@@ -414,41 +455,112 @@ func upgradeRunCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	// Set the ID before the upgrade starts, so that progress checkpoint is persisted in state
+	// even if the upgrade fails or times out. Next apply will resume from the checkpoint.
+	d.SetId(id)
+	handleError := func(err error) error {
+		return handleUpgradeRunError(d, isCreate, err)
+	}
+	checkpoints := getUpgradeCheckpoints(d)
+
+	paused := d.Get("paused").(bool)
+	if paused {
+		err = pauseUpgrade(upgradeClientSet, d, checkpoints)
+		if err != nil {
+			return handleError(err)
+		}
+	}
+
 	log.Printf("[INFO] Updating UpgradeUnitGroup and UpgradePlanSetting.")
-	err = prepareUpgrade(upgradeClientSet, d, targetVersion)
+	err = prepareUpgrade(upgradeClientSet, d, checkpoints, targetVersion)
 	if err != nil {
-		return handleCreateError("NsxtUpgradeRun", id, err)
+		return handleError(err)
+	}
+
+	if paused {
+		log.Printf("[INFO] Upgrade is paused, set paused to false in order to resume it.")
+		return resourceNsxtUpgradeRunRead(d, m)
 	}
 
 	log.Printf("[INFO] Successfully update UpgradeUnitGroup and UpgradePlanSetting. Start Upgrade.")
 	finalizeUpgrade := true
-	if d.HasChange("finalize_upgrade_setting") {
-		finalizeSettings := d.Get("finalize_upgrade_setting").([]interface{})
-		if len(finalizeSettings) != 0 {
-			finalizeSettingsMap := finalizeSettings[0].(map[string]interface{})
-			finalizeUpgrade = finalizeSettingsMap["enabled"].(bool)
-		}
+	finalizeSettings := d.Get("finalize_upgrade_setting").([]interface{})
+	if len(finalizeSettings) != 0 {
+		finalizeSettingsMap := finalizeSettings[0].(map[string]interface{})
+		finalizeUpgrade = finalizeSettingsMap["enabled"].(bool)
 	}
 
-	err = runUpgrade(upgradeClientSet, getPartialUpgradeMap(d, targetVersion), targetVersion, finalizeUpgrade)
+	err = runUpgrade(upgradeClientSet, d, checkpoints, getPartialUpgradeMap(d, targetVersion), targetVersion, finalizeUpgrade)
 	if err != nil {
-		return handleCreateError("NsxtUpgradeRun", id, err)
+		return handleError(err)
 	}
 
 	runPostcheck(upgradeClientSet.UpgradeClient, d)
 
-	d.SetId(id)
 	return resourceNsxtUpgradeRunRead(d, m)
 }
 
-func prepareUpgrade(upgradeClientSet *upgradeClientSet, d *schema.ResourceData, targetVersion string) error {
-	for _, component := range getUpgradeComponentList(targetVersion) {
+// handleUpgradeRunError keeps resource ID when create fails after upgrade progress was persisted,
+// so that the failure is reported while the progress is saved in state. Terraform marks such
+// resource as tainted, and its replacement resumes the upgrade based on upgrade coordinator status.
+func handleUpgradeRunError(d *schema.ResourceData, isCreate bool, err error) error {
+	if !isCreate {
+		return handleUpdateError("NsxtUpgradeRun", d.Id(), err)
+	}
+	if len(getUpgradeCheckpoints(d)) == 0 {
+		id := d.Id()
+		d.SetId("")
+		return handleCreateError("NsxtUpgradeRun", id, err)
+	}
+	log.Printf("[INFO] Upgrade progress is persisted in state, the upgrade will be resumed on next apply")
+	return handleCreateError("NsxtUpgradeRun", d.Id(), err)
+}
+
+// Pause an in-flight upgrade and wait until it settles
+func pauseUpgrade(upgradeClientSet *upgradeClientSet, d *schema.ResourceData, checkpoints upgradeCheckpoints) error {
+	status, err := getUpgradeStatus(upgradeClientSet.StatusClient, nil)
+	if err != nil {
+		return err
+	}
+	if status.Status == model.ComponentUpgradeStatus_STATUS_IN_PROGRESS {
+		log.Printf("[INFO] Pausing upgrade")
+		err = upgradeClientSet.PlanClient.Pause()
+		if err != nil {
+			return err
+		}
+	}
+	err = waitUpgradeForStatus(upgradeClientSet, nil, inFlightComponentUpgradeStatus, append(staticComponentUpgradeStatus, model.ComponentUpgradeStatus_STATUS_SUCCESS))
+	if err != nil {
+		return err
+	}
+
+	for component, checkpoint := range checkpoints {
+		c := component
+		status, err := getUpgradeStatus(upgradeClientSet.StatusClient, &c)
+		if err != nil {
+			return err
+		}
+		checkpoint.Status = status.Status
+	}
+	setUpgradeCheckpoints(d, checkpoints)
+	return nil
+}
+
+func prepareUpgrade(upgradeClientSet *upgradeClientSet, d *schema.ResourceData, checkpoints upgradeCheckpoints, targetVersion string) error {
+	for _, c := range getUpgradeComponentList(targetVersion) {
+		component := c
 		// Customize MP upgrade is not allowed
 		if component == mpUpgradeGroup || component == finalizeUpgradeGroup {
 			continue
 		}
 
-		if !d.HasChange(componentToGroupKey[component]) && !d.HasChange(componentToSettingKey[component]) {
+		checksum := getUpgradePlanChecksum(d, component)
+		checkpoint, hasCheckpoint := checkpoints[component]
+		if hasCheckpoint && checkpoint.PlanChecksum == checksum {
+			// This plan has already been applied to the component, resume from current state
+			continue
+		}
+		if !hasCheckpoint && !d.HasChange(componentToGroupKey[component]) && !d.HasChange(componentToSettingKey[component]) {
 			continue
 		}
 
@@ -461,6 +573,17 @@ func prepareUpgrade(upgradeClientSet *upgradeClientSet, d *schema.ResourceData,
 			log.Printf("[WARN] %s upgrade is already succeed. Any changes on it will be ignored.", component)
 			continue
 		}
+		inFlight := status.Status == model.ComponentUpgradeStatus_STATUS_IN_PROGRESS || status.Status == model.ComponentUpgradeStatus_STATUS_PAUSING
+		if !hasCheckpoint && inFlight {
+			// Upgrade of this component is running, but its progress wasn't persisted, e.g. when
+			// Terraform was interrupted before. Resetting the plan now could leave groups partially reset,
+			// hence resume with the plan which is already in place. Paused or failed upgrade is
+			// reconfigured as usual.
+			log.Printf("[INFO] %s upgrade is already started with status %s, resuming with current upgrade plan", component, status.Status)
+			checkpoints.get(component).PlanChecksum = checksum
+			checkpoints.get(component).Status = status.Status
+			continue
+		}
 		// If a component upgrade is in progress, to update either UpgradeUnitGroup or UpgradePlanSetting,
 		// we should pause it first. Update an in-flight component will receive an error from API.
 		if status.Status == model.ComponentUpgradeStatus_STATUS_IN_PROGRESS {
@@ -493,7 +616,14 @@ func prepareUpgrade(upgradeClientSet *upgradeClientSet, d *schema.ResourceData,
 		if err != nil {
 			return err
 		}
+
+		checkpoints[component] = &upgradeCheckpoint{
+			Status:       model.ComponentUpgradeStatus_STATUS_NOT_STARTED,
+			PlanChecksum: checksum,
+		}
+		setUpgradeCheckpoints(d, checkpoints)
 	}
+	setUpgradeCheckpoints(d, checkpoints)
 	return nil
 }
 
@@ -525,6 +655,60 @@ type upgradeStatusAndDetail struct {
 	Detail string
 }
 
+// Upgrade progress of a component, persisted in state in order to resume interrupted upgrade
+type upgradeCheckpoint struct {
+	Status       string
+	PlanChecksum string
+	Completed    bool
+}
+
+type upgradeCheckpoints map[string]*upgradeCheckpoint
+
+func (c upgradeCheckpoints) get(component string) *upgradeCheckpoint {
+	if _, ok := c[component]; !ok {
+		c[component] = &upgradeCheckpoint{}
+	}
+	return c[component]
+}
+
+func getUpgradeCheckpoints(d *schema.ResourceData) upgradeCheckpoints {
+	checkpoints := make(upgradeCheckpoints)
+	for _, progressI := range d.Get("progress").([]interface{}) {
+		progress := progressI.(map[string]interface{})
+		checkpoints[progress["component"].(string)] = &upgradeCheckpoint{
+			Status:       progress["status"].(string),
+			PlanChecksum: progress["plan_checksum"].(string),
+			Completed:    progress["completed"].(bool),
+		}
+	}
+	return checkpoints
+}
+
+func setUpgradeCheckpoints(d *schema.ResourceData, checkpoints upgradeCheckpoints) {
+	var progressList []map[string]interface{}
+	// upgradeComponentListPost9 contains all the components
+	for _, component := range upgradeComponentListPost9 {
+		checkpoint, ok := checkpoints[component]
+		if !ok {
+			continue
+		}
+		elem := make(map[string]interface{})
+		elem["component"] = component
+		elem["status"] = checkpoint.Status
+		elem["plan_checksum"] = checkpoint.PlanChecksum
+		elem["completed"] = checkpoint.Completed
+		progressList = append(progressList, elem)
+	}
+	d.Set("progress", progressList)
+}
+
+// Checksum of upgrade group and setting configuration for the component
+func getUpgradePlanChecksum(d *schema.ResourceData, component string) string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("%v-%v", d.Get(componentToGroupKey[component]), d.Get(componentToSettingKey[component])))
+	return fmt.Sprintf("%08x", crc32.ChecksumIEEE(buf.Bytes()))
+}
+
 // Get component upgrade status. Using nil component for overall upgrade status.
 func getUpgradeStatus(statusClient upgrade.StatusSummaryClient, component *string) (*upgradeStatusAndDetail, error) {
 	status, err := statusClient.Get(component, nil, nil)
@@ -771,7 +955,7 @@ func updateComponentUpgradePlanSetting(settingClient plan.SettingsClient, d *sch
 	return err
 }
 
-func runUpgrade(upgradeClientSet *upgradeClientSet, partialUpgradeMap map[string]bool, targetVersion string, finalizeUpgrade bool) error {
+func runUpgrade(upgradeClientSet *upgradeClientSet, d *schema.ResourceData, checkpoints upgradeCheckpoints, partialUpgradeMap map[string]bool, targetVersion string, finalizeUpgrade bool) error {
 	partialUpgradeExist := false
 	prevComponent := ""
 	for _, c := range getUpgradeComponentList(targetVersion) {
@@ -783,7 +967,7 @@ func runUpgrade(upgradeClientSet *upgradeClientSet, partialUpgradeMap map[string
 		// there is a period that overall status is still IN_PROGRESS, which will prevent us to start the upgrade of next component.
 		// Wait here for the overall status become stable. Because there is potential upgrade triggered before, we wait here also
 		// for the first component for safety.
-		err := waitUpgradeForStatus(upgradeClientSet, nil, inFlightComponentUpgradeStatus, staticComponentUpgradeStatus)
+		err := waitUpgradeForStatus(upgradeClientSet, nil, inFlightComponentUpgradeStatus, append(staticComponentUpgradeStatus, model.ComponentUpgradeStatus_STATUS_SUCCESS))
 		if err != nil {
 			return err
 		}
@@ -800,15 +984,6 @@ func runUpgrade(upgradeClientSet *upgradeClientSet, partialUpgradeMap map[string
 			}
 		}
 
-		// If component is already upgraded, resume
-		status, err := getUpgradeStatus(upgradeClientSet.StatusClient, &component)
-		if err != nil {
-			return err
-		}
-		if status.Status == model.ComponentUpgradeStatus_STATUS_SUCCESS {
-			log.Printf("Component %s already upgraded successfully, skipping", component)
-			continue
-		}
 		pendingStatus := []string{model.ComponentUpgradeStatus_STATUS_IN_PROGRESS}
 		targetStatus := []string{model.ComponentUpgradeStatus_STATUS_SUCCESS}
 		completeLog := fmt.Sprintf("[INFO] %s upgrade is completed.", component)
@@ -820,6 +995,30 @@ func runUpgrade(upgradeClientSet *upgradeClientSet, partialUpgradeMap map[string
 			prevComponent = component
 			completeLog = fmt.Sprintf("[INFO] %s upgrade is partially completed.", component)
 		}
+
+		// If component is already upgraded, resume
+		status, err := getUpgradeStatus(upgradeClientSet.StatusClient, &component)
+		if err != nil {
+			return err
+		}
+		checkpoint := checkpoints.get(component)
+		checkpoint.Status = status.Status
+		if status.Status == model.ComponentUpgradeStatus_STATUS_SUCCESS {
+			log.Printf("Component %s already upgraded successfully, skipping", component)
+			checkpoint.Completed = true
+			setUpgradeCheckpoints(d, checkpoints)
+			continue
+		}
+		if checkpoint.Completed && partialUpgradeMap[component] && status.Status == model.ComponentUpgradeStatus_STATUS_PAUSED {
+			log.Printf("Component %s already partially upgraded, skipping", component)
+			continue
+		}
+
+		// Mark the component as started before triggering the upgrade, so that an interrupted upgrade is resumed
+		checkpoint.Completed = false
+		checkpoint.Status = model.ComponentUpgradeStatus_STATUS_IN_PROGRESS
+		setUpgradeCheckpoints(d, checkpoints)
+
 		err = upgradeClientSet.PlanClient.Upgrade(&component)
 		if err != nil {
 			return err
@@ -828,6 +1027,12 @@ func runUpgrade(upgradeClientSet *upgradeClientSet, partialUpgradeMap map[string
 		if err != nil {
 			return err
 		}
+		status, err = getUpgradeStatus(upgradeClientSet.StatusClient, &component)
+		if err == nil {
+			checkpoint.Status = status.Status
+		}
+		checkpoint.Completed = true
+		setUpgradeCheckpoints(d, checkpoints)
 		log.Print(completeLog)
 	}
 	return nil
@@ -901,6 +1106,20 @@ func setUpgradeRunOutput(upgradeClientSet *upgradeClientSet, d *schema.ResourceD
 		states = append(states, elem)
 	}
 	d.Set("state", states)
+
+	// Refresh progress checkpoint with current upgrade status
+	checkpoints := getUpgradeCheckpoints(d)
+	for _, result := range status.ComponentStatus {
+		checkpoint, ok := checkpoints[*result.ComponentType]
+		if !ok {
+			continue
+		}
+		checkpoint.Status = *result.Status
+		if checkpoint.Status == model.ComponentUpgradeStatus_STATUS_SUCCESS {
+			checkpoint.Completed = true
+		}
+	}
+	setUpgradeCheckpoints(d, checkpoints)
 	return nil
 }
 
@@ -924,6 +1143,21 @@ func resourceNsxtUpgradeRunUpdate(d *schema.ResourceData, m interface{}) error {
 	return upgradeRunCreateOrUpdate(d, m)
 }
 
+// Force an update when upgrade of some component was started but not completed, in order to resume it
+func resourceNsxtUpgradeRunCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || d.Get("paused").(bool) {
+		return nil
+	}
+	for _, progressI := range d.Get("progress").([]interface{}) {
+		progress := progressI.(map[string]interface{})
+		if !progress["completed"].(bool) && progress["status"].(string) != model.ComponentUpgradeStatus_STATUS_NOT_STARTED {
+			log.Printf("[INFO] Upgrade of %s component is not completed, will be resumed", progress["component"])
+			return d.SetNewComputed("progress")
+		}
+	}
+	return nil
+}
+
 func resourceNsxtUpgradeRunDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
@@ -0,0 +1,1112 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+// Package simulator implements an in-process fake of NSX policy and MP APIs,
+// sufficient for running provider acceptance tests without a live NSX manager.
+//
+// The simulator is intentionally generic: it keeps any object it is given,
+// keyed by its API path, and does not validate object content. Behavior that
+// depends on NSX business logic (realization, default objects beyond the
+// basic ones, cross-object validation) is not simulated.
+package simulator
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	policyPrefix        = "/policy/api/v1"
+	globalManagerPrefix = "/global-manager/api/v1"
+	mpPrefix            = "/api/v1"
+
+	// DefaultVersion is the NSX version reported by the simulator unless specified otherwise
+	DefaultVersion = "4.2.0"
+
+	simulatorUser = "admin"
+
+	defaultPageSize = 1000
+
+	tagOperationsCollection = "/infra/tags/tag-operations"
+
+	migratedResourcesCollection = mpPrefix + "/migration/migrated-resources"
+)
+
+// promotionCollections maps MP to policy promotion resource types to the
+// policy collection promoted objects are placed in
+var promotionCollections = map[string]string{
+	"LOGICAL_SWITCH":    "/infra/segments",
+	"LOGICAL_ROUTER":    "/infra/tier-1s",
+	"NS_GROUP":          "/infra/domains/default/groups",
+	"IP_SET":            "/infra/domains/default/groups",
+	"FIREWALL_SECTION":  "/infra/domains/default/security-policies",
+	"NS_SERVICE":        "/infra/services",
+	"NS_SERVICE_GROUP":  "/infra/services",
+	"IP_POOL":           "/infra/ip-pools",
+	"IP_BLOCK":          "/infra/ip-blocks",
+	"LB_SERVICE":        "/infra/lb-services",
+	"LB_POOL":           "/infra/lb-pools",
+	"LB_VIRTUAL_SERVER": "/infra/lb-virtual-servers",
+}
+
+// resourceTypeCollections maps policy resource types to the name of the
+// collection they are kept in. It is used to assign resource_type to objects
+// that do not specify it, and to place children of hierarchical API requests.
+var resourceTypeCollections = map[string]string{
+	"Domain":                               "domains",
+	"Group":                                "groups",
+	"SecurityPolicy":                       "security-policies",
+	"GatewayPolicy":                        "gateway-policies",
+	"Rule":                                 "rules",
+	"IdsSecurityPolicy":                    "intrusion-service-policies",
+	"IdsGatewayPolicy":                     "intrusion-service-gateway-policies",
+	"IdsRule":                              "rules",
+	"RedirectionPolicy":                    "redirection-policies",
+	"RedirectionRule":                      "rules",
+	"Tier0":                                "tier-0s",
+	"Tier1":                                "tier-1s",
+	"LocaleServices":                       "locale-services",
+	"Tier0Interface":                       "interfaces",
+	"Tier1Interface":                       "interfaces",
+	"Segment":                              "segments",
+	"SegmentPort":                          "ports",
+	"Service":                              "services",
+	"PolicyContextProfile":                 "context-profiles",
+	"IpAddressPool":                        "ip-pools",
+	"IpAddressBlock":                       "ip-blocks",
+	"IpAddressAllocation":                  "ip-allocations",
+	"DhcpServerConfig":                     "dhcp-server-configs",
+	"DhcpRelayConfig":                      "dhcp-relay-configs",
+	"PolicyNat":                            "nat",
+	"PolicyNatRule":                        "nat-rules",
+	"StaticRoutes":                         "static-routes",
+	"PolicyDnsForwarderZone":               "dns-forwarder-zones",
+	"Project":                              "projects",
+	"Vpc":                                  "vpcs",
+	"VpcSubnet":                            "subnets",
+	"Org":                                  "orgs",
+	"Site":                                 "sites",
+	"EnforcementPoint":                     "enforcement-points",
+	"PolicyServiceChain":                   "service-chains",
+	"PolicyServiceProfile":                 "service-profiles",
+	"ServiceReference":                     "service-references",
+	"MalwarePreventionProfile":             "profiles",
+	"IdsProfile":                           "profiles",
+	"Tier0RouteMap":                        "route-maps",
+	"PrefixList":                           "prefix-lists",
+	"CommunityList":                        "community-lists",
+	"BgpNeighborConfig":                    "neighbors",
+	"PolicyFirewallFloodProtectionProfile": "flood-protection-profiles",
+	"MacDiscoveryProfile":                  "mac-discovery-profiles",
+	"IPDiscoveryProfile":                   "ip-discovery-profiles",
+	"SegmentSecurityProfile":               "segment-security-profiles",
+	"SpoofGuardProfile":                    "spoofguard-profiles",
+	"QoSProfile":                           "qos-profiles",
+	"TagBulkOperation":                     "tag-operations",
+}
+
+// additionalCollections are known collections that have no resource type
+// with a fixed mapping, listing these returns an empty result rather than
+// a not found error.
+var additionalCollections = []string{
+	"licenses",
+	"ip-subnets",
+	"service-entries",
+	"transport-zones",
+	"edge-clusters",
+	"edge-nodes",
+	"transport-nodes",
+	"compute-managers",
+	"compute-collections",
+}
+
+var embeddedCollections = []string{"rules"}
+
+type apiError struct {
+	status  int
+	code    int
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func notFoundError(key string) *apiError {
+	return &apiError{
+		status:  http.StatusNotFound,
+		code:    600,
+		message: fmt.Sprintf("The path=[%s] is invalid", key),
+	}
+}
+
+func badRequestError(format string, a ...interface{}) *apiError {
+	return &apiError{
+		status:  http.StatusBadRequest,
+		code:    255,
+		message: fmt.Sprintf(format, a...),
+	}
+}
+
+// Server is a fake NSX manager serving policy and MP APIs over TLS
+type Server struct {
+	*httptest.Server
+
+	// Version is reported by node version API
+	Version string
+
+	mu      sync.Mutex
+	objects map[string]map[string]interface{}
+	// user recorded as creator and last modifier of objects
+	user string
+}
+
+// NewServer starts a new simulator. Caller is responsible for closing it.
+func NewServer(version string) *Server {
+	if version == "" {
+		version = DefaultVersion
+	}
+	s := &Server{
+		Version: version,
+		objects: make(map[string]map[string]interface{}),
+		user:    simulatorUser,
+	}
+	s.seed()
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Host returns address of the simulator in host:port format
+func (s *Server) Host() string {
+	return strings.TrimPrefix(s.URL, "https://")
+}
+
+// Get returns a copy of the object stored under given policy path or MP URI
+func (s *Server) Get(key string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.objects[key]
+	if !ok {
+		return nil, false
+	}
+	return s.render(key, obj), true
+}
+
+// Put stores an object under given policy path or MP URI, bypassing revision checks.
+// This is useful to pre-create objects that tests expect to exist on NSX.
+func (s *Server) Put(key string, obj map[string]interface{}) error {
+	return s.PutAs(key, obj, simulatorUser)
+}
+
+// PutAs stores an object same as Put, recording given user as the one who made
+// the change. This is useful to simulate changes made outside of Terraform.
+func (s *Server) PutAs(key string, obj map[string]interface{}, user string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.user = user
+	defer func() { s.user = simulatorUser }()
+	if err := s.upsert(key, copyObject(obj), false, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Reset removes all objects except the default ones
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.objects = make(map[string]map[string]interface{})
+	s.seed()
+}
+
+func (s *Server) seed() {
+	defaults := map[string]string{
+		"/infra":                 "Infra",
+		"/infra/domains/default": "Domain",
+		"/infra/sites/default":   "Site",
+		"/infra/sites/default/enforcement-points/default": "EnforcementPoint",
+		"/orgs/default":                 "Org",
+		"/global-infra":                 "Infra",
+		"/global-infra/domains/default": "Domain",
+	}
+	for key, resourceType := range defaults {
+		_ = s.upsert(key, map[string]interface{}{"resource_type": resourceType}, false, false)
+		// Default objects are owned by the system, same as on real NSX
+		s.objects[key]["_system_owned"] = true
+		s.objects[key]["_create_user"] = "system"
+	}
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, badRequestError("Failed to read request body: %v", err))
+		return
+	}
+	log.Printf("[DEBUG] NSX simulator: %s %s", r.Method, r.URL.RequestURI())
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	uri := strings.TrimSuffix(r.URL.Path, "/")
+	switch {
+	case uri == "/api/session/create":
+		w.Header().Set("Set-Cookie", "JSESSIONID=simulator; Path=/; Secure; HttpOnly")
+		w.Header().Set("X-XSRF-TOKEN", "simulator")
+		w.WriteHeader(http.StatusOK)
+		return
+	case strings.HasSuffix(uri, "/node/version"):
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"node_version":    s.Version,
+			"product_version": s.Version,
+		})
+		return
+	case strings.HasSuffix(uri, "/search/query") || strings.HasSuffix(uri, "/search"):
+		query := r.URL.Query()
+		writeJSON(w, http.StatusOK, s.search(query.Get("query"), query.Get("cursor"), query.Get("page_size")))
+		return
+	case strings.HasSuffix(uri, "/realized-state/realized-entities"):
+		writeJSON(w, http.StatusOK, s.realizedEntities(r.URL.Query().Get("intent_path")))
+		return
+	case uri == mpPrefix+"/migration/mp-policy-promotion/state":
+		// Promotion is completed synchronously, hence never in progress
+		writeJSON(w, http.StatusOK, map[string]interface{}{"mp_policy_promotion": "PROMOTION_NOT_IN_PROGRESS"})
+		return
+	case uri == migratedResourcesCollection && r.Method == http.MethodGet:
+		query := r.URL.Query()
+		writeJSON(w, http.StatusOK, s.migratedResources(query.Get("resource_type"), query.Get("resource_id")))
+		return
+	}
+
+	var payload map[string]interface{}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &payload); err != nil {
+			writeError(w, badRequestError("Failed to parse request body: %v", err))
+			return
+		}
+	}
+
+	key, isPolicy := objectKey(uri)
+	var status int
+	var result interface{}
+	var apiErr *apiError
+	switch r.Method {
+	case http.MethodGet:
+		status, result, apiErr = s.handleGet(key)
+	case http.MethodPatch:
+		status, result, apiErr = s.handleWrite(key, payload, true)
+	case http.MethodPut:
+		status, result, apiErr = s.handleWrite(key, payload, false)
+		if apiErr == nil && key == tagOperationsCollection+"/"+lastSegment(key) {
+			s.applyTagOperation(key)
+		}
+	case http.MethodPost:
+		if key == mpPrefix+"/migration/mp-to-policy" {
+			s.promoteToPolicy(payload)
+			status = http.StatusOK
+			break
+		}
+		status, result, apiErr = s.handlePost(key, payload, isPolicy, r.URL.Query().Get("action"))
+	case http.MethodDelete:
+		s.delete(key)
+		status = http.StatusOK
+	default:
+		apiErr = &apiError{status: http.StatusMethodNotAllowed, code: 255, message: "Method not supported by simulator"}
+	}
+
+	if apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+	writeJSON(w, status, result)
+}
+
+// objectKey converts request URI to object key, which is policy path for
+// policy objects, and the full URI for MP objects
+func objectKey(uri string) (string, bool) {
+	for _, prefix := range []string{policyPrefix, globalManagerPrefix} {
+		if strings.HasPrefix(uri, prefix+"/") {
+			return strings.TrimPrefix(uri, prefix), true
+		}
+	}
+	return uri, false
+}
+
+func (s *Server) handleGet(key string) (int, interface{}, *apiError) {
+	if obj, ok := s.objects[key]; ok {
+		return http.StatusOK, s.render(key, obj), nil
+	}
+
+	children := s.children(key)
+	if len(children) > 0 || isKnownCollection(lastSegment(key)) {
+		return http.StatusOK, listResult(children), nil
+	}
+
+	return 0, nil, notFoundError(key)
+}
+
+func (s *Server) handleWrite(key string, payload map[string]interface{}, merge bool) (int, interface{}, *apiError) {
+	if payload == nil {
+		payload = make(map[string]interface{})
+	}
+
+	if existing, ok := s.objects[key]; ok {
+		if revision, ok := payload["_revision"]; ok && toInt64(revision) != toInt64(existing["_revision"]) {
+			return 0, nil, &apiError{
+				status:  http.StatusPreconditionFailed,
+				code:    604,
+				message: fmt.Sprintf("The object %s was modified by somebody else", key),
+			}
+		}
+	}
+
+	if err := s.upsert(key, payload, merge, true); err != nil {
+		return 0, nil, err
+	}
+
+	if merge {
+		return http.StatusOK, nil, nil
+	}
+	return http.StatusOK, s.render(key, s.objects[key]), nil
+}
+
+func (s *Server) handlePost(key string, payload map[string]interface{}, isPolicy bool, action string) (int, interface{}, *apiError) {
+	if action != "" {
+		// Actions are acknowledged without side effects
+		if obj, ok := s.objects[key]; ok {
+			return http.StatusOK, s.render(key, obj), nil
+		}
+		if payload == nil {
+			payload = make(map[string]interface{})
+		}
+		return http.StatusOK, payload, nil
+	}
+
+	if payload == nil {
+		payload = make(map[string]interface{})
+	}
+
+	if _, ok := s.objects[key]; ok && isPolicy {
+		// Policy POST on existing object is equivalent to PUT
+		return s.handleWrite(key, payload, false)
+	}
+
+	// Create in collection with generated ID
+	id := newUUID()
+	if existingID, ok := payload["id"].(string); ok && existingID != "" && isPolicy {
+		id = existingID
+	}
+	childKey := key + "/" + id
+	if err := s.upsert(childKey, payload, false, true); err != nil {
+		return 0, nil, err
+	}
+
+	return http.StatusCreated, s.render(childKey, s.objects[childKey]), nil
+}
+
+// applyTagOperation applies tag bulk operation to target objects, and records
+// operation status. Policy objects are identified by path, virtual machines by
+// external ID.
+func (s *Server) applyTagOperation(key string) {
+	operation := s.objects[key]
+	tag, _ := operation["tag"].(map[string]interface{})
+	result := map[string]interface{}{
+		"path":   key,
+		"tag":    tag,
+		"status": "Success",
+	}
+	for _, action := range []string{"apply_to", "remove_from"} {
+		var typeStatusList []interface{}
+		infoList, _ := operation[action].([]interface{})
+		for _, item := range infoList {
+			info, _ := item.(map[string]interface{})
+			ids, _ := info["resource_ids"].([]interface{})
+			var statusList []interface{}
+			for _, id := range ids {
+				resourceID, _ := id.(string)
+				status := map[string]interface{}{"resource_id": resourceID, "tag_status": "Success"}
+				if targetKey := s.findTagTarget(resourceID); targetKey != "" {
+					target := s.objects[targetKey]
+					target["tags"] = updateTagList(target["tags"], tag, action == "apply_to")
+					target["_revision"] = toInt64(target["_revision"]) + 1
+					target["_last_modified_time"] = time.Now().UnixMilli()
+					target["_last_modified_user"] = s.user
+				} else {
+					status["tag_status"] = "Error"
+					status["details"] = fmt.Sprintf("Object %s not found", resourceID)
+					result["status"] = "Error"
+				}
+				statusList = append(statusList, status)
+			}
+			typeStatusList = append(typeStatusList, map[string]interface{}{
+				"resource_type":       info["resource_type"],
+				"resource_tag_status": statusList,
+			})
+		}
+		result[action] = typeStatusList
+	}
+	s.objects[key+"/status"] = result
+}
+
+// promoteToPolicy creates policy counterparts of MP objects listed in MP to
+// policy promotion request, and records them as migrated resources. Objects
+// that do not exist are not promoted.
+func (s *Server) promoteToPolicy(payload map[string]interface{}) {
+	migrationData, _ := payload["migration_data"].([]interface{})
+	for _, item := range migrationData {
+		data, _ := item.(map[string]interface{})
+		resourceType, _ := data["type"].(string)
+		collection, ok := promotionCollections[resourceType]
+		if !ok {
+			continue
+		}
+		resourceIDs, _ := data["resource_ids"].([]interface{})
+		for _, resourceID := range resourceIDs {
+			details, _ := resourceID.(map[string]interface{})
+			mpID, _ := details["manager_id"].(string)
+			mpKey := s.findMPObject(mpID)
+			if mpKey == "" {
+				continue
+			}
+			mpObj := s.objects[mpKey]
+			policyCollection := collection
+			if resourceType == "LOGICAL_ROUTER" && mpObj["router_type"] == "TIER0" {
+				policyCollection = "/infra/tier-0s"
+			}
+			policyKey := policyCollection + "/" + mpID
+			s.upsert(policyKey, map[string]interface{}{"display_name": mpObj["display_name"]}, false, false)
+			s.upsert(migratedResourcesCollection+"/"+mpID, map[string]interface{}{
+				"resource_type": resourceType,
+				"resource_id":   mpID,
+				"policy_id":     mpID,
+				"policy_path":   policyKey,
+			}, false, false)
+		}
+	}
+}
+
+// findMPObject returns key of MP object with given ID
+func (s *Server) findMPObject(id string) string {
+	for key := range s.objects {
+		if strings.HasPrefix(key, mpPrefix+"/") && lastSegment(key) == id && !strings.HasPrefix(key, migratedResourcesCollection+"/") {
+			return key
+		}
+	}
+	return ""
+}
+
+// migratedResources lists promoted objects of given type, optionally
+// filtered by MP ID
+func (s *Server) migratedResources(resourceType string, resourceID string) map[string]interface{} {
+	var result []map[string]interface{}
+	for _, obj := range s.children(migratedResourcesCollection) {
+		if obj["resource_type"] != resourceType || (resourceID != "" && obj["resource_id"] != resourceID) {
+			continue
+		}
+		result = append(result, obj)
+	}
+	return listResult(result)
+}
+
+// findTagTarget returns key of object with given policy path, or of virtual
+// machine with given external ID
+func (s *Server) findTagTarget(id string) string {
+	if _, ok := s.objects[id]; ok && strings.HasPrefix(id, "/") {
+		return id
+	}
+	for key, obj := range s.objects {
+		if obj["resource_type"] == "VirtualMachine" && obj["external_id"] == id {
+			return key
+		}
+	}
+	return ""
+}
+
+func updateTagList(value interface{}, tag map[string]interface{}, add bool) []interface{} {
+	var result []interface{}
+	found := false
+	tags, _ := value.([]interface{})
+	for _, item := range tags {
+		existing, _ := item.(map[string]interface{})
+		if tagValue(existing, "scope") == tagValue(tag, "scope") && tagValue(existing, "tag") == tagValue(tag, "tag") {
+			found = true
+			if !add {
+				continue
+			}
+		}
+		result = append(result, item)
+	}
+	if add && !found {
+		result = append(result, tag)
+	}
+	return result
+}
+
+func tagValue(tag map[string]interface{}, field string) string {
+	value, _ := tag[field].(string)
+	return value
+}
+
+// upsert creates or updates an object, processing hierarchical children and
+// embedded collections of the payload
+func (s *Server) upsert(key string, payload map[string]interface{}, merge bool, processChildren bool) *apiError {
+	children, hasChildren := payload["children"].([]interface{})
+	delete(payload, "children")
+
+	embedded := make(map[string][]interface{})
+	for _, collection := range embeddedCollections {
+		if list, ok := payload[collection].([]interface{}); ok {
+			embedded[collection] = list
+			delete(payload, collection)
+		}
+	}
+
+	now := time.Now().UnixMilli()
+	existing, exists := s.objects[key]
+	var obj map[string]interface{}
+	if exists && merge {
+		obj = copyObject(existing)
+		for k, v := range payload {
+			obj[k] = v
+		}
+	} else {
+		obj = payload
+	}
+
+	id := lastSegment(key)
+	obj["id"] = id
+	if _, ok := obj["display_name"]; !ok {
+		obj["display_name"] = id
+	}
+	if _, ok := obj["resource_type"]; !ok {
+		if resourceType := collectionResourceType(parentCollection(key)); resourceType != "" {
+			obj["resource_type"] = resourceType
+		}
+	}
+	if strings.HasPrefix(key, "/") && !strings.HasPrefix(key, mpPrefix+"/") {
+		obj["path"] = key
+		obj["relative_path"] = id
+		obj["parent_path"] = parentObjectPath(key)
+		obj["marked_for_delete"] = false
+		obj["overridden"] = false
+	}
+	if exists {
+		obj["_revision"] = toInt64(existing["_revision"]) + 1
+		obj["_create_time"] = existing["_create_time"]
+		obj["_create_user"] = existing["_create_user"]
+		obj["unique_id"] = existing["unique_id"]
+	} else {
+		obj["_revision"] = int64(0)
+		obj["_create_time"] = now
+		obj["_create_user"] = s.user
+		obj["unique_id"] = newUUID()
+	}
+	obj["realization_id"] = obj["unique_id"]
+	obj["_last_modified_time"] = now
+	obj["_last_modified_user"] = s.user
+	obj["_system_owned"] = false
+	obj["_protection"] = "NOT_PROTECTED"
+	s.objects[key] = obj
+
+	for collection, list := range embedded {
+		present := make(map[string]bool)
+		for _, item := range list {
+			child, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			childID, _ := child["id"].(string)
+			if childID == "" {
+				childID = newUUID()
+			}
+			present[childID] = true
+			if err := s.upsert(key+"/"+collection+"/"+childID, child, merge, processChildren); err != nil {
+				return err
+			}
+		}
+		if !merge {
+			// Full update replaces embedded collection
+			for _, child := range s.children(key + "/" + collection) {
+				if childID, _ := child["id"].(string); !present[childID] {
+					s.delete(key + "/" + collection + "/" + childID)
+				}
+			}
+		}
+	}
+
+	if hasChildren && processChildren {
+		return s.applyChildren(key, children)
+	}
+	return nil
+}
+
+// applyChildren processes children of hierarchical API request
+func (s *Server) applyChildren(parentKey string, children []interface{}) *apiError {
+	for _, item := range children {
+		child, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		wrapperType, _ := child["resource_type"].(string)
+		if wrapperType == "ChildResourceReference" {
+			id, _ := child["id"].(string)
+			targetType, _ := child["target_type"].(string)
+			collection, ok := resourceTypeCollections[targetType]
+			if !ok {
+				return badRequestError("Resource type %s is not supported by simulator", targetType)
+			}
+			grandChildren, _ := child["children"].([]interface{})
+			if err := s.applyChildren(parentKey+"/"+collection+"/"+id, grandChildren); err != nil {
+				return err
+			}
+			continue
+		}
+
+		obj, _ := child[strings.TrimPrefix(wrapperType, "Child")].(map[string]interface{})
+		if obj == nil {
+			return badRequestError("Child object is missing for %s", wrapperType)
+		}
+		resourceType, _ := obj["resource_type"].(string)
+		if resourceType == "" {
+			resourceType = strings.TrimPrefix(wrapperType, "Child")
+		}
+		collection, ok := resourceTypeCollections[resourceType]
+		if !ok {
+			return badRequestError("Resource type %s is not supported by simulator", resourceType)
+		}
+		id, _ := obj["id"].(string)
+		if id == "" {
+			return badRequestError("ID is missing for child %s", resourceType)
+		}
+
+		key := parentKey + "/" + collection + "/" + id
+		if markedForDelete, _ := child["marked_for_delete"].(bool); markedForDelete {
+			s.delete(key)
+			continue
+		}
+		if err := s.upsert(key, copyObject(obj), true, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// delete removes object along with all its descendants
+func (s *Server) delete(key string) {
+	delete(s.objects, key)
+	for k := range s.objects {
+		if strings.HasPrefix(k, key+"/") {
+			delete(s.objects, k)
+		}
+	}
+}
+
+// children returns objects directly under given collection path
+func (s *Server) children(collection string) []map[string]interface{} {
+	var keys []string
+	for k := range s.objects {
+		if strings.HasPrefix(k, collection+"/") && !strings.Contains(strings.TrimPrefix(k, collection+"/"), "/") {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var result []map[string]interface{}
+	for _, k := range keys {
+		result = append(result, s.render(k, s.objects[k]))
+	}
+	return result
+}
+
+// render returns a copy of stored object, with embedded collections populated
+func (s *Server) render(key string, obj map[string]interface{}) map[string]interface{} {
+	result := copyObject(obj)
+	for _, collection := range embeddedCollections {
+		children := s.children(key + "/" + collection)
+		if len(children) == 0 {
+			continue
+		}
+		sort.SliceStable(children, func(i, j int) bool {
+			return toInt64(children[i]["sequence_number"]) < toInt64(children[j]["sequence_number"])
+		})
+		var list []interface{}
+		for _, child := range children {
+			list = append(list, child)
+		}
+		result[collection] = list
+	}
+	return result
+}
+
+// search returns a page of objects matching the query. Cursor is the offset
+// of the page, same as on NSX the cursor is returned if more results remain.
+func (s *Server) search(query string, cursor string, pageSize string) map[string]interface{} {
+	matcher := parseQuery(query)
+
+	var keys []string
+	for k := range s.objects {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var results []map[string]interface{}
+	for _, k := range keys {
+		obj := s.render(k, s.objects[k])
+		if matcher.match(obj) {
+			results = append(results, obj)
+		}
+	}
+
+	offset, _ := strconv.Atoi(cursor)
+	size, err := strconv.Atoi(pageSize)
+	if err != nil || size <= 0 {
+		size = defaultPageSize
+	}
+	if offset < 0 || offset > len(results) {
+		offset = len(results)
+	}
+	end := offset + size
+	if end > len(results) {
+		end = len(results)
+	}
+
+	page := listResult(results[offset:end])
+	page["result_count"] = len(results)
+	if end < len(results) {
+		page["cursor"] = strconv.Itoa(end)
+	}
+	return page
+}
+
+func (s *Server) realizedEntities(intentPath string) map[string]interface{} {
+	var results []map[string]interface{}
+	if obj, ok := s.objects[intentPath]; ok {
+		results = append(results, map[string]interface{}{
+			"id":                              newUUID(),
+			"resource_type":                   "GenericPolicyRealizedResource",
+			"state":                           "REALIZED",
+			"runtime_status":                  "UNINITIALIZED",
+			"intent_paths":                    []string{intentPath},
+			"realization_specific_identifier": obj["unique_id"],
+			"path":                            intentPath,
+		})
+	}
+	return listResult(results)
+}
+
+func listResult(results []map[string]interface{}) map[string]interface{} {
+	list := make([]interface{}, 0, len(results))
+	for _, r := range results {
+		list = append(list, r)
+	}
+	return map[string]interface{}{
+		"results":      list,
+		"result_count": len(list),
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body == nil {
+		return
+	}
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("[ERROR] NSX simulator failed to encode response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, err *apiError) {
+	writeJSON(w, err.status, map[string]interface{}{
+		"httpStatus":    strings.ReplaceAll(strings.ToUpper(http.StatusText(err.status)), " ", "_"),
+		"error_code":    err.code,
+		"module_name":   "simulator",
+		"error_message": err.message,
+	})
+}
+
+func isKnownCollection(name string) bool {
+	for _, collection := range resourceTypeCollections {
+		if collection == name {
+			return true
+		}
+	}
+	for _, collection := range additionalCollections {
+		if collection == name {
+			return true
+		}
+	}
+	return false
+}
+
+func collectionResourceType(collection string) string {
+	var candidates []string
+	for resourceType, c := range resourceTypeCollections {
+		if c == collection {
+			candidates = append(candidates, resourceType)
+		}
+	}
+	// Ambiguous collections (such as rules) are left for the client to specify
+	if len(candidates) != 1 {
+		return ""
+	}
+	return candidates[0]
+}
+
+func lastSegment(key string) string {
+	return key[strings.LastIndex(key, "/")+1:]
+}
+
+func parentCollection(key string) string {
+	segments := strings.Split(key, "/")
+	if len(segments) < 3 {
+		return ""
+	}
+	return segments[len(segments)-2]
+}
+
+func parentObjectPath(key string) string {
+	segments := strings.Split(strings.TrimPrefix(key, "/"), "/")
+	if len(segments) <= 2 {
+		return "/" + segments[0]
+	}
+	return "/" + strings.Join(segments[:len(segments)-2], "/")
+}
+
+func copyObject(obj map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(obj))
+	for k, v := range obj {
+		result[k] = v
+	}
+	return result
+}
+
+func toInt64(v interface{}) int64 {
+	switch n := v.(type) {
+	case int64:
+		return n
+	case int:
+		return int64(n)
+	case float64:
+		return int64(n)
+	case json.Number:
+		i, _ := n.Int64()
+		return i
+	}
+	return 0
+}
+
+func newUUID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// queryMatcher evaluates the subset of NSX search syntax used by the provider:
+// field:value terms with wildcards and escaped characters, combined with AND
+// and OR operators and grouped with parentheses.
+type queryMatcher interface {
+	match(obj map[string]interface{}) bool
+}
+
+type queryAnd []queryMatcher
+
+type queryOr []queryMatcher
+
+type queryTerm struct {
+	field   string
+	pattern *regexp.Regexp
+}
+
+func parseQuery(query string) queryMatcher {
+	query = strings.TrimSpace(query)
+	// OR binds weaker than AND
+	if parts := splitUnescaped(query, " OR "); len(parts) > 1 {
+		var matcher queryOr
+		for _, part := range parts {
+			matcher = append(matcher, parseQuery(part))
+		}
+		return matcher
+	}
+	if parts := splitUnescaped(query, " AND "); len(parts) > 1 {
+		var matcher queryAnd
+		for _, part := range parts {
+			matcher = append(matcher, parseQuery(part))
+		}
+		return matcher
+	}
+	if isGrouped(query) {
+		return parseQuery(query[1 : len(query)-1])
+	}
+	return parseTerm(query)
+}
+
+// isGrouped checks whether the whole expression is enclosed in parentheses
+func isGrouped(expr string) bool {
+	if !strings.HasPrefix(expr, "(") || !strings.HasSuffix(expr, ")") {
+		return false
+	}
+	depth := 0
+	for i := 0; i < len(expr); i++ {
+		switch expr[i] {
+		case '\\':
+			i++
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 && i < len(expr)-1 {
+				return false
+			}
+		}
+	}
+	return depth == 0
+}
+
+func parseTerm(term string) queryTerm {
+	field := ""
+	value := term
+	for i := 0; i < len(term); i++ {
+		if term[i] == '\\' {
+			i++
+			continue
+		}
+		if term[i] == ':' {
+			field = term[:i]
+			value = term[i+1:]
+			break
+		}
+	}
+
+	value = strings.Trim(value, "\"")
+	var pattern strings.Builder
+	pattern.WriteString("(?i)^")
+	for i := 0; i < len(value); i++ {
+		switch {
+		case value[i] == '\\' && i+1 < len(value):
+			i++
+			pattern.WriteString(regexp.QuoteMeta(string(value[i])))
+		case value[i] == '*':
+			pattern.WriteString(".*")
+		case value[i] == '?':
+			pattern.WriteString(".")
+		default:
+			pattern.WriteString(regexp.QuoteMeta(string(value[i])))
+		}
+	}
+	pattern.WriteString("$")
+
+	return queryTerm{
+		field:   field,
+		pattern: regexp.MustCompile(pattern.String()),
+	}
+}
+
+func splitUnescaped(s string, sep string) []string {
+	var result []string
+	depth := 0
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+			continue
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		if depth == 0 && strings.HasPrefix(s[i:], sep) {
+			result = append(result, s[start:i])
+			start = i + len(sep)
+			i = start - 1
+		}
+	}
+	if start < len(s) {
+		result = append(result, s[start:])
+	}
+	return result
+}
+
+func (m queryAnd) match(obj map[string]interface{}) bool {
+	for _, matcher := range m {
+		if !matcher.match(obj) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m queryOr) match(obj map[string]interface{}) bool {
+	for _, matcher := range m {
+		if matcher.match(obj) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t queryTerm) match(obj map[string]interface{}) bool {
+	var values []string
+	if t.field == "" {
+		for _, v := range obj {
+			values = append(values, fieldValues(v, nil)...)
+		}
+	} else {
+		values = fieldValues(obj, strings.Split(t.field, "."))
+	}
+
+	for _, v := range values {
+		if t.pattern.MatchString(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldValues returns string representations of value under given field path,
+// descending into lists
+func fieldValues(v interface{}, fieldPath []string) []string {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		if len(fieldPath) == 0 {
+			return nil
+		}
+		return fieldValues(value[fieldPath[0]], fieldPath[1:])
+	case []interface{}:
+		var result []string
+		for _, item := range value {
+			result = append(result, fieldValues(item, fieldPath)...)
+		}
+		return result
+	case []string:
+		if len(fieldPath) > 0 {
+			return nil
+		}
+		return value
+	case nil:
+		return nil
+	}
+	if len(fieldPath) > 0 {
+		return nil
+	}
+	return []string{fmt.Sprintf("%v", v)}
+}
@@ -0,0 +1,321 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package simulator
+
+import (
+	"crypto/tls"
+	"net/http"
+	"testing"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/security"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/node"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/realized_state"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/search"
+)
+
+func newTestConnector(s *Server) client.Connector {
+	securityCtx := core.NewSecurityContextImpl()
+	securityCtx.SetProperty(security.AUTHENTICATION_SCHEME_ID, security.USER_PASSWORD_SCHEME_ID)
+	securityCtx.SetProperty(security.USER_KEY, "admin")
+	securityCtx.SetProperty(security.PASSWORD_KEY, "password")
+
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+	httpClient := http.Client{Transport: tr}
+	return client.NewConnector(s.URL, client.UsingRest(nil), client.WithHttpClient(&httpClient), client.WithSecurityContext(securityCtx))
+}
+
+func TestSimulatorVersion(t *testing.T) {
+	s := NewServer("3.2.1")
+	defer s.Close()
+
+	version, err := node.NewVersionClient(newTestConnector(s)).Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version.NodeVersion == nil || *version.NodeVersion != "3.2.1" {
+		t.Errorf("Unexpected node version %v", version.NodeVersion)
+	}
+}
+
+func TestSimulatorCRUD(t *testing.T) {
+	s := NewServer("")
+	defer s.Close()
+
+	client := infra.NewTier1sClient(newTestConnector(s))
+	name := "test-tier1"
+	description := "test description"
+	err := client.Patch("t1", model.Tier1{DisplayName: &name, Description: &description})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	obj, err := client.Get("t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *obj.DisplayName != name || *obj.Path != "/infra/tier-1s/t1" || *obj.ParentPath != "/infra" || *obj.ResourceType != "Tier1" {
+		t.Errorf("Unexpected object %s at %s under %s of type %s", *obj.DisplayName, *obj.Path, *obj.ParentPath, *obj.ResourceType)
+	}
+	if *obj.Revision != 0 {
+		t.Errorf("Expected revision 0, got %d", *obj.Revision)
+	}
+
+	// Partial update keeps fields that are not specified
+	newName := "updated-tier1"
+	err = client.Patch("t1", model.Tier1{DisplayName: &newName})
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj, err = client.Get("t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *obj.DisplayName != newName || obj.Description == nil || *obj.Description != description || *obj.Revision != 1 {
+		t.Errorf("Unexpected object after update: %s, %v, revision %d", *obj.DisplayName, obj.Description, *obj.Revision)
+	}
+
+	list, err := client.List(nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *list.ResultCount != 1 {
+		t.Errorf("Expected 1 object in list, got %d", *list.ResultCount)
+	}
+
+	err = client.Delete("t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Get("t1")
+	if err == nil {
+		t.Errorf("Expected not found error after delete")
+	}
+}
+
+func TestSimulatorRevisionConflict(t *testing.T) {
+	s := NewServer("")
+	defer s.Close()
+
+	client := domains.NewGroupsClient(newTestConnector(s))
+	err := client.Patch("default", "g1", model.Group{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	staleRevision := int64(5)
+	_, err = client.Update("default", "g1", model.Group{Revision: &staleRevision})
+	if err == nil {
+		t.Fatalf("Expected revision conflict error")
+	}
+
+	currentRevision := int64(0)
+	obj, err := client.Update("default", "g1", model.Group{Revision: &currentRevision})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *obj.Revision != 1 {
+		t.Errorf("Expected revision 1, got %d", *obj.Revision)
+	}
+}
+
+func TestSimulatorEmbeddedRules(t *testing.T) {
+	s := NewServer("")
+	defer s.Close()
+
+	client := domains.NewSecurityPoliciesClient(newTestConnector(s))
+	rule1 := "r1"
+	rule2 := "r2"
+	seq1 := int64(20)
+	seq2 := int64(10)
+	policy := model.SecurityPolicy{
+		Rules: []model.Rule{
+			{Id: &rule1, SequenceNumber: &seq1},
+			{Id: &rule2, SequenceNumber: &seq2},
+		},
+	}
+	err := client.Patch("default", "p1", policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	obj, err := client.Get("default", "p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(obj.Rules) != 2 || *obj.Rules[0].Id != rule2 || *obj.Rules[1].Path != "/infra/domains/default/security-policies/p1/rules/r1" {
+		t.Errorf("Unexpected rules %v", obj.Rules)
+	}
+
+	// Full update replaces the rule set
+	policy = model.SecurityPolicy{
+		Rules:    []model.Rule{{Id: &rule1, SequenceNumber: &seq1}},
+		Revision: obj.Revision,
+	}
+	obj, err = client.Update("default", "p1", policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(obj.Rules) != 1 {
+		t.Errorf("Expected single rule after update, got %d", len(obj.Rules))
+	}
+}
+
+func TestSimulatorHierarchicalAPI(t *testing.T) {
+	s := NewServer("")
+	defer s.Close()
+
+	err := s.Put("/infra/domains/default/groups/g1", map[string]interface{}{"display_name": "g1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := map[string]interface{}{
+		"resource_type": "Infra",
+		"children": []interface{}{
+			map[string]interface{}{
+				"resource_type": "ChildResourceReference",
+				"id":            "default",
+				"target_type":   "Domain",
+				"children": []interface{}{
+					map[string]interface{}{
+						"resource_type": "ChildGroup",
+						"Group": map[string]interface{}{
+							"id":            "g2",
+							"resource_type": "Group",
+						},
+					},
+					map[string]interface{}{
+						"resource_type":     "ChildGroup",
+						"marked_for_delete": true,
+						"Group": map[string]interface{}{
+							"id":            "g1",
+							"resource_type": "Group",
+						},
+					},
+				},
+			},
+		},
+	}
+	s.mu.Lock()
+	_, _, apiErr := s.handleWrite("/infra", body, true)
+	s.mu.Unlock()
+	if apiErr != nil {
+		t.Fatal(apiErr)
+	}
+
+	if _, ok := s.Get("/infra/domains/default/groups/g2"); !ok {
+		t.Errorf("Expected group g2 to be created")
+	}
+	if _, ok := s.Get("/infra/domains/default/groups/g1"); ok {
+		t.Errorf("Expected group g1 to be deleted")
+	}
+}
+
+func TestSimulatorSearch(t *testing.T) {
+	s := NewServer("")
+	defer s.Close()
+
+	groups := map[string]string{
+		"/infra/domains/default/groups/g1":                          "web-servers",
+		"/infra/domains/default/groups/g2":                          "db-servers",
+		"/orgs/default/projects/p1/infra/domains/default/groups/g3": "web-servers",
+	}
+	for path, name := range groups {
+		err := s.Put(path, map[string]interface{}{"display_name": name, "resource_type": "Group"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	client := search.NewQueryClient(newTestConnector(s))
+	tests := []struct {
+		query    string
+		expected int64
+	}{
+		{"resource_type:Group AND display_name:web-servers AND marked_for_delete:false", 2},
+		{"resource_type:Group AND display_name:WEB* AND path:\\/infra*", 1},
+		{"resource_type:Group AND path:\\/orgs\\/default\\/projects\\/p1*", 1},
+		{"resource_type:Group AND (display_name:db-servers OR display_name:none)", 1},
+		{"resource_type:Segment", 0},
+		{"(resource_type:Group AND display_name:db-servers) OR resource_type:Segment", 1},
+		{"resource_type:Group AND (display_name:db* OR (display_name:web* AND path:\\/infra*))", 2},
+	}
+
+	for _, test := range tests {
+		result, err := client.List(test.query, nil, nil, nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *result.ResultCount != test.expected {
+			t.Errorf("Query %s: expected %d results, got %d", test.query, test.expected, *result.ResultCount)
+		}
+	}
+}
+
+func TestSimulatorSearchPagination(t *testing.T) {
+	s := NewServer("")
+	defer s.Close()
+
+	for _, id := range []string{"s1", "s2", "s3", "s4", "s5"} {
+		err := s.Put("/infra/segments/"+id, map[string]interface{}{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	client := search.NewQueryClient(newTestConnector(s))
+	pageSize := int64(2)
+	var cursor *string
+	var ids []string
+	for pages := 1; ; pages++ {
+		result, err := client.List("resource_type:Segment", cursor, nil, &pageSize, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *result.ResultCount != 5 || len(result.Results) > 2 {
+			t.Fatalf("Unexpected page with %d out of %d results", len(result.Results), *result.ResultCount)
+		}
+		for _, r := range result.Results {
+			id, _ := r.Field("id")
+			ids = append(ids, id.(*data.StringValue).Value())
+		}
+		cursor = result.Cursor
+		if cursor == nil {
+			if pages != 3 {
+				t.Errorf("Expected 3 pages, got %d", pages)
+			}
+			break
+		}
+	}
+	if len(ids) != 5 || ids[0] != "s1" || ids[4] != "s5" {
+		t.Errorf("Unexpected objects %v", ids)
+	}
+}
+
+func TestSimulatorRealization(t *testing.T) {
+	s := NewServer("")
+	defer s.Close()
+
+	err := s.Put("/infra/tier-1s/t1", map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := realized_state.NewRealizedEntitiesClient(newTestConnector(s))
+	result, err := client.List("/infra/tier-1s/t1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Results) != 1 || *result.Results[0].State != model.GenericPolicyRealizedResource_STATE_REALIZED {
+		t.Errorf("Expected realized entity for existing intent")
+	}
+}
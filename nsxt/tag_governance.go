@@ -0,0 +1,273 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vmware-nsxt/common"
+)
+
+// tagGovernanceConfig holds provider level tag settings, applied to all
+// resources that support tags
+type tagGovernanceConfig struct {
+	// tags added to every resource, unless resource sets a tag with same scope
+	DefaultTags []common.Tag
+	// tags with these scopes are neither reported nor removed by terraform
+	IgnoreScopes []string
+	// plan fails for resources that lack tags with these scopes
+	RequiredScopes []string
+}
+
+func (c tagGovernanceConfig) modifiesTags() bool {
+	return len(c.DefaultTags) > 0 || len(c.IgnoreScopes) > 0
+}
+
+func initTagGovernanceConfig(d *schema.ResourceData) tagGovernanceConfig {
+	var config tagGovernanceConfig
+	if defaults, ok := d.GetOk("default_tags"); ok && len(defaults.([]interface{})) > 0 && defaults.([]interface{})[0] != nil {
+		config.DefaultTags = getTagsFromSetValue(defaults.([]interface{})[0].(map[string]interface{})["tag"])
+	}
+	if ignore, ok := d.GetOk("ignore_tags"); ok && len(ignore.([]interface{})) > 0 && ignore.([]interface{})[0] != nil {
+		config.IgnoreScopes = interface2StringList(ignore.([]interface{})[0].(map[string]interface{})["scopes"].([]interface{}))
+	}
+	config.RequiredScopes = interface2StringList(d.Get("required_tag_scopes").([]interface{}))
+
+	return config
+}
+
+func getTagGovernanceConfig(m interface{}) tagGovernanceConfig {
+	clients, ok := m.(nsxtClients)
+	if !ok {
+		return tagGovernanceConfig{}
+	}
+	return clients.CommonConfig.TagGovernance
+}
+
+func getTagsFromSetValue(value interface{}) []common.Tag {
+	set, ok := value.(*schema.Set)
+	if !ok {
+		return nil
+	}
+	var tags []common.Tag
+	for _, item := range set.List() {
+		data := item.(map[string]interface{})
+		tags = append(tags, common.Tag{
+			Scope: data["scope"].(string),
+			Tag:   data["tag"].(string),
+		})
+	}
+	return tags
+}
+
+func tagsToSchemaValue(tags []common.Tag) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, map[string]interface{}{"scope": tag.Scope, "tag": tag.Tag})
+	}
+	return result
+}
+
+func containsTag(tags []common.Tag, tag common.Tag) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func containsTagScope(tags []common.Tag, scope string) bool {
+	for _, t := range tags {
+		if t.Scope == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeTags returns tags to be sent to NSX: configured tags, default tags with
+// scopes that are not configured, and previously detected tags with ignored scopes
+func mergeTags(configured []common.Tag, config tagGovernanceConfig, previous []common.Tag) []common.Tag {
+	result := append([]common.Tag{}, configured...)
+	for _, tag := range config.DefaultTags {
+		if !containsTagScope(configured, tag.Scope) {
+			result = append(result, tag)
+		}
+	}
+	for _, tag := range previous {
+		if shouldIgnoreScope(tag.Scope, config.IgnoreScopes) && !containsTag(result, tag) {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// filterTags returns tags to be reported in resource tag attribute, excluding
+// ignored scopes and default tags that were not configured explicitly
+func filterTags(tags []common.Tag, config tagGovernanceConfig, configured []common.Tag) []common.Tag {
+	var result []common.Tag
+	for _, tag := range tags {
+		if shouldIgnoreScope(tag.Scope, config.IgnoreScopes) {
+			continue
+		}
+		if containsTag(config.DefaultTags, tag) && !containsTag(configured, tag) {
+			continue
+		}
+		result = append(result, tag)
+	}
+	return result
+}
+
+func tagsKey(tags []common.Tag) string {
+	var keys []string
+	for _, tag := range tags {
+		keys = append(keys, tag.Scope+"="+tag.Tag)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+// supportsTagGovernance checks whether resource has standard tag attribute
+func supportsTagGovernance(resource *schema.Resource) bool {
+	tagSchema, ok := resource.Schema["tag"]
+	if !ok || tagSchema.Type != schema.TypeSet || !tagSchema.Optional {
+		return false
+	}
+	elem, ok := tagSchema.Elem.(*schema.Resource)
+	if !ok || len(elem.Schema) != 2 || elem.Schema["scope"] == nil || elem.Schema["tag"] == nil {
+		return false
+	}
+	_, exists := resource.Schema["tags_all"]
+	return !exists
+}
+
+// addTagGovernance applies provider level tag settings to resources with standard
+// tag attribute. Resource CRUD functions keep handling tag attribute as usual: tags
+// are merged before create and update, and filtered after read.
+func addTagGovernance(resources map[string]*schema.Resource) {
+	for name, resource := range resources {
+		if !supportsTagGovernance(resource) {
+			continue
+		}
+
+		resource.Schema["tags_all"] = &schema.Schema{
+			Type:        schema.TypeSet,
+			Description: "All tags assigned to the resource on NSX, including provider default tags and ignored tags",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"scope": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"tag": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		}
+		if resource.Create != nil {
+			resource.Create = schema.CreateFunc(wrapTagGovernanceWrite(resource.Create))
+		}
+		if resource.Update != nil {
+			resource.Update = schema.UpdateFunc(wrapTagGovernanceWrite(resource.Update))
+		}
+		if resource.Read != nil {
+			resource.Read = schema.ReadFunc(wrapTagGovernanceRead(resource.Read))
+		}
+
+		validation := getTagGovernanceCustomizeDiff(name, resource.Update != nil)
+		if resource.CustomizeDiff == nil {
+			resource.CustomizeDiff = validation
+		} else {
+			resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, validation)
+		}
+	}
+}
+
+// setGovernedTags reports tags as read from NSX in tags_all, and tags managed
+// by resource configuration in tag
+func setGovernedTags(d *schema.ResourceData, config tagGovernanceConfig, configured []common.Tag) {
+	tags := getTagsFromSetValue(d.Get("tag"))
+	if err := d.Set("tags_all", tagsToSchemaValue(tags)); err != nil {
+		log.Printf("[WARNING] Failed to set tags_all in schema: %v", err)
+	}
+	if !config.modifiesTags() {
+		return
+	}
+	if err := d.Set("tag", tagsToSchemaValue(filterTags(tags, config, configured))); err != nil {
+		log.Printf("[WARNING] Failed to set tag in schema: %v", err)
+	}
+}
+
+func wrapTagGovernanceWrite(write func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		config := getTagGovernanceConfig(m)
+		configured := getTagsFromSetValue(d.Get("tag"))
+		if config.modifiesTags() {
+			previous, _ := d.GetChange("tags_all")
+			merged := mergeTags(configured, config, getTagsFromSetValue(previous))
+			if err := d.Set("tag", tagsToSchemaValue(merged)); err != nil {
+				return err
+			}
+		}
+
+		err := write(d, m)
+		if d.Id() != "" {
+			setGovernedTags(d, config, configured)
+		}
+		return err
+	}
+}
+
+func wrapTagGovernanceRead(read func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		configured := getTagsFromSetValue(d.Get("tag"))
+		err := read(d, m)
+		if err == nil && d.Id() != "" {
+			setGovernedTags(d, getTagGovernanceConfig(m), configured)
+		}
+		return err
+	}
+}
+
+func getTagGovernanceCustomizeDiff(name string, updatable bool) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		config := getTagGovernanceConfig(m)
+		if !d.NewValueKnown("tag") {
+			return nil
+		}
+		configured := getTagsFromSetValue(d.Get("tag"))
+
+		var missing []string
+		effective := mergeTags(configured, config, nil)
+		for _, scope := range config.RequiredScopes {
+			if !containsTagScope(effective, scope) {
+				missing = append(missing, scope)
+			}
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("%s is missing tags with required scopes: %s", name, strings.Join(missing, ", "))
+		}
+
+		if !config.modifiesTags() || !updatable || d.Id() == "" {
+			return nil
+		}
+		// Trigger update if default tags changed since last apply
+		previous, _ := d.GetChange("tags_all")
+		previousTags := getTagsFromSetValue(previous)
+		if tagsKey(mergeTags(configured, config, previousTags)) != tagsKey(previousTags) {
+			return d.SetNewComputed("tags_all")
+		}
+		return nil
+	}
+}
@@ -0,0 +1,127 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/go-vmware-nsxt/common"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestMergeAndFilterTags(t *testing.T) {
+	config := tagGovernanceConfig{
+		DefaultTags:  []common.Tag{{Scope: "owner", Tag: "netops"}, {Scope: "env", Tag: "prod"}},
+		IgnoreScopes: []string{"vra"},
+	}
+	configured := []common.Tag{{Scope: "env", Tag: "dev"}, {Scope: "app", Tag: "web"}}
+	previous := []common.Tag{{Scope: "env", Tag: "dev"}, {Scope: "vra", Tag: "d1"}}
+
+	merged := mergeTags(configured, config, previous)
+	expected := "app=web,env=dev,owner=netops,vra=d1"
+	if tagsKey(merged) != expected {
+		t.Errorf("Expected merged tags %s, got %s", expected, tagsKey(merged))
+	}
+
+	filtered := filterTags(merged, config, configured)
+	if tagsKey(filtered) != "app=web,env=dev" {
+		t.Errorf("Expected filtered tags to match configuration, got %s", tagsKey(filtered))
+	}
+
+	// default tag specified explicitly in resource configuration is reported
+	configured = append(configured, common.Tag{Scope: "owner", Tag: "netops"})
+	filtered = filterTags(merged, config, configured)
+	if tagsKey(filtered) != "app=web,env=dev,owner=netops" {
+		t.Errorf("Expected explicit default tag to be reported, got %s", tagsKey(filtered))
+	}
+}
+
+func getSimulatorObjectTags(t *testing.T, server *simulator.Server, path string) string {
+	obj, ok := server.Get(path)
+	if !ok {
+		t.Fatalf("Object %s not found", path)
+	}
+	var keys []string
+	tags, _ := obj["tags"].([]interface{})
+	for _, tag := range tags {
+		data := tag.(map[string]interface{})
+		keys = append(keys, data["scope"].(string)+"="+data["tag"].(string))
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+func TestTagGovernanceLifecycle(t *testing.T) {
+	server, provider := testSimulatorProvider(t, "", map[string]interface{}{
+		"default_tags": []interface{}{map[string]interface{}{
+			"tag": []interface{}{map[string]interface{}{"scope": "owner", "tag": "netops"}},
+		}},
+		"ignore_tags":         []interface{}{map[string]interface{}{"scopes": []interface{}{"vra"}}},
+		"required_tag_scopes": []interface{}{"env"},
+	})
+	m := provider.Meta()
+
+	res := provider.ResourcesMap["nsxt_policy_mac_discovery_profile"]
+	if res.Schema["tags_all"] == nil {
+		t.Fatalf("Expected tags_all attribute in resource schema")
+	}
+
+	_, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"display_name": "test",
+		"tag":          []interface{}{map[string]interface{}{"scope": "app", "tag": "web"}},
+	}), m)
+	if err == nil || !strings.Contains(err.Error(), "required scopes: env") {
+		t.Errorf("Expected missing required scope error, got %v", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"display_name": "test",
+		"tag":          []interface{}{map[string]interface{}{"scope": "env", "tag": "dev"}},
+	})
+	if err := res.Create(d, m); err != nil {
+		t.Fatal(err)
+	}
+	path := d.Get("path").(string)
+	if tags := getSimulatorObjectTags(t, server, path); tags != "env=dev,owner=netops" {
+		t.Errorf("Expected default tag to be assigned on NSX, got %s", tags)
+	}
+	if d.Get("tag.#").(int) != 1 || d.Get("tags_all.#").(int) != 2 {
+		t.Errorf("Unexpected tags after create: tag %v, tags_all %v", d.Get("tag"), d.Get("tags_all"))
+	}
+
+	// tag assigned outside of terraform with ignored scope
+	obj, _ := server.Get(path)
+	obj["tags"] = append(obj["tags"].([]interface{}), map[string]interface{}{"scope": "vra", "tag": "d1"})
+	if err := server.Put(path, obj); err != nil {
+		t.Fatal(err)
+	}
+	if err := res.Read(d, m); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("tag.#").(int) != 1 || d.Get("tags_all.#").(int) != 3 {
+		t.Errorf("Unexpected tags after read: tag %v, tags_all %v", d.Get("tag"), d.Get("tags_all"))
+	}
+
+	d = res.Data(d.State())
+	d.Set("tag", []interface{}{map[string]interface{}{"scope": "env", "tag": "prod"}})
+	if err := res.Update(d, m); err != nil {
+		t.Fatal(err)
+	}
+	if tags := getSimulatorObjectTags(t, server, path); tags != "env=prod,owner=netops,vra=d1" {
+		t.Errorf("Expected ignored and default tags to be preserved on update, got %s", tags)
+	}
+	if d.Get("tag.#").(int) != 1 {
+		t.Errorf("Unexpected tags after update: %v", d.Get("tag"))
+	}
+
+	if err := res.Delete(d, m); err != nil {
+		t.Fatal(err)
+	}
+}
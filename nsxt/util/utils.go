@@ -12,6 +12,10 @@ import (
 
 var NsxVersion = ""
 
+// NsxVersionEstimated indicates NsxVersion could not be retrieved and was estimated
+// based on API availability, and thus can not be relied on for validation
+var NsxVersionEstimated = false
+
 func NsxVersionLower(ver string) bool {
 	return VersionLower(NsxVersion, ver)
 }
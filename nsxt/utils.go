@@ -584,6 +584,7 @@ func getNSXVersion(connector client.Connector) (string, error) {
 func initNSXVersion(connector client.Connector) error {
 	var err error
 	util.NsxVersion, err = getNSXVersion(connector)
+	util.NsxVersionEstimated = false
 	return err
 }
 
@@ -592,6 +593,7 @@ func initNSXVersionVMC(clients interface{}) {
 	// For now, we need to determine whether the deployment is 3.0.0 and up, or below
 	// For this purpose, we fire indicator search API (introduced in 3.0.0)
 	util.NsxVersion = "3.0.0"
+	util.NsxVersionEstimated = true
 
 	connector := getPolicyConnector(clients)
 	client := search.NewQueryClient(connector)
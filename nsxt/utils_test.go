@@ -225,10 +225,50 @@ func getTestLdapBaseDN() string {
 	return os.Getenv("NSXT_TEST_LDAP_BASE_DN")
 }
 
+func getTestLdapNetbiosName() string {
+	return os.Getenv("NSXT_TEST_LDAP_NETBIOS_NAME")
+}
+
+func getTestLdapGroupName() string {
+	return os.Getenv("NSXT_TEST_LDAP_GROUP_NAME")
+}
+
 func getTestManagerClusterNode() string {
 	return os.Getenv("NSXT_TEST_MANAGER_CLUSTER_NODE")
 }
 
+func getTestPartnerServiceName() string {
+	return os.Getenv("NSXT_TEST_PARTNER_SERVICE_NAME")
+}
+
+func getTestPartnerVendorTemplateName() string {
+	return os.Getenv("NSXT_TEST_PARTNER_VENDOR_TEMPLATE_NAME")
+}
+
+func getTestServiceSegmentPath() string {
+	return os.Getenv("NSXT_TEST_SERVICE_SEGMENT_PATH")
+}
+
+func getTestDeploymentServiceID() string {
+	return os.Getenv("NSXT_TEST_DEPLOYMENT_SERVICE_ID")
+}
+
+func getTestDeploymentSpecName() string {
+	return os.Getenv("NSXT_TEST_DEPLOYMENT_SPEC_NAME")
+}
+
+func getTestDeploymentTemplateName() string {
+	return os.Getenv("NSXT_TEST_DEPLOYMENT_TEMPLATE_NAME")
+}
+
+func getTestDatastoreID() string {
+	return os.Getenv("NSXT_TEST_DATASTORE_ID")
+}
+
+func getTestManagementNetworkID() string {
+	return os.Getenv("NSXT_TEST_MANAGEMENT_NETWORK_ID")
+}
+
 func testAccEnvDefined(t *testing.T, envVar string) {
 	if len(os.Getenv(envVar)) == 0 {
 		t.Skipf("This test requires %s environment variable to be set", envVar)
@@ -0,0 +1,288 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
+)
+
+// nsxtVersionRequirement describes minimal NSX versions for a resource and its attributes
+type nsxtVersionRequirement struct {
+	// minimal NSX version for the resource, empty if resource is supported by all versions
+	version string
+	// minimal NSX version per attribute, with nested attributes separated by dot. Numeric
+	// segment refers to list element, e.g. "rule.1" requires NSX version for more than one rule
+	attributes map[string]string
+	// minimal NSX version per attribute value, for values introduced later than the attribute
+	values map[string]map[string]string
+	// NSX version per attribute value, from which the value is no longer supported
+	removedValues map[string]map[string]string
+}
+
+// resourceVersionRequirements lists NSX version requirements for hand-written resources,
+// validated at plan time and on apply. Metadata-driven resources derive attribute requirements from
+// IntroducedInVersion instead.
+var resourceVersionRequirements = map[string]nsxtVersionRequirement{
+	"nsxt_policy_evpn_config":                 {version: "3.1.0"},
+	"nsxt_policy_evpn_tenant":                 {version: "3.1.0"},
+	"nsxt_policy_evpn_tunnel_endpoint":        {version: "3.1.0"},
+	"nsxt_policy_intrusion_service_policy":    {version: "3.1.0"},
+	"nsxt_policy_intrusion_service_profile":   {version: "3.1.0"},
+	"nsxt_policy_ospf_area":                   {version: "3.1.1"},
+	"nsxt_policy_ospf_config":                 {version: "3.1.1"},
+	"nsxt_policy_ospf_summary_address":        {version: "3.1.1"},
+	"nsxt_policy_project":                     {version: "4.1.0"},
+	"nsxt_policy_tier0_inter_vrf_routing":     {version: "4.1.0"},
+	"nsxt_policy_vtep_ha_host_switch_profile": {version: "4.1.0"},
+	"nsxt_policy_malware_prevention_profile":  {version: "4.1.0"},
+	"nsxt_policy_share":                       {version: "4.1.1"},
+	"nsxt_policy_shared_resource":             {version: "4.1.1"},
+	"nsxt_policy_tier0_gateway_gre_tunnel":    {version: "4.1.2"},
+	"nsxt_policy_bulk_tags":                   {version: "4.1.2"},
+	"nsxt_nat_rule": {
+		removedValues: map[string]map[string]string{"action": {"NO_NAT": "3.0.0"}},
+	},
+	"nsxt_policy_bgp_neighbor": {
+		attributes: map[string]string{"route_filtering.1": "3.0.0"},
+		values: map[string]map[string]string{
+			"route_filtering.address_family": {model.BgpRouteFiltering_ADDRESS_FAMILY_L2VPN_EVPN: "3.0.0"},
+		},
+	},
+	"nsxt_policy_group": {
+		attributes: map[string]string{"group_type": "3.2.0"},
+	},
+	"nsxt_policy_lb_service": {
+		values: map[string]map[string]string{"size": {model.LBService_SIZE_XLARGE: "3.0.0"}},
+	},
+	"nsxt_policy_nat_rule": {
+		attributes: map[string]string{"policy_based_vpn_mode": "4.0.0"},
+	},
+	"nsxt_policy_ip_block": {
+		attributes: map[string]string{"visibility": "4.2.0"},
+	},
+	"nsxt_policy_tier1_gateway": {
+		attributes: map[string]string{"multicast_config": "4.1.1"},
+		values:     map[string]map[string]string{"ha_mode": {model.Tier1_HA_MODE_ACTIVE: "4.0.0"}},
+	},
+}
+
+// addVersionValidation attaches NSX version validation to resources listed in
+// resourceVersionRequirements. Validation happens at plan time, and is repeated on
+// create and update since NSX version might not be known when planning.
+func addVersionValidation(resources map[string]*schema.Resource) {
+	for name, requirement := range resourceVersionRequirements {
+		resource, ok := resources[name]
+		if !ok {
+			continue
+		}
+		resource.CustomizeDiff = chainVersionValidation(resource.CustomizeDiff, name, requirement)
+		addApplyVersionValidation(resource, name, requirement)
+	}
+}
+
+func addApplyVersionValidation(resource *schema.Resource, name string, requirement nsxtVersionRequirement) {
+	if create := resource.Create; create != nil {
+		resource.Create = func(d *schema.ResourceData, m interface{}) error {
+			if err := validateNSXVersionRequirementOnApply(d, m, name, requirement); err != nil {
+				return err
+			}
+			return create(d, m)
+		}
+	}
+	if update := resource.Update; update != nil {
+		resource.Update = func(d *schema.ResourceData, m interface{}) error {
+			if err := validateNSXVersionRequirementOnApply(d, m, name, requirement); err != nil {
+				return err
+			}
+			return update(d, m)
+		}
+	}
+	if create := resource.CreateContext; create != nil {
+		resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			if err := validateNSXVersionRequirementOnApply(d, m, name, requirement); err != nil {
+				return diag.FromErr(err)
+			}
+			return create(ctx, d, m)
+		}
+	}
+	if update := resource.UpdateContext; update != nil {
+		resource.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			if err := validateNSXVersionRequirementOnApply(d, m, name, requirement); err != nil {
+				return diag.FromErr(err)
+			}
+			return update(ctx, d, m)
+		}
+	}
+}
+
+// validateNSXVersionRequirementOnApply validates version requirement against NSX
+// version known at apply time. Unlike plan time validation, estimated version is
+// validated against as well.
+func validateNSXVersionRequirementOnApply(d *schema.ResourceData, m interface{}, name string, requirement nsxtVersionRequirement) error {
+	if util.NsxVersion == "" {
+		getPolicyConnector(m)
+	}
+	if util.NsxVersion == "" {
+		log.Printf("[DEBUG] NSX version is not known, skipping version validation for %s", name)
+		return nil
+	}
+
+	return validateNSXVersionRequirement(name, requirement, util.NsxVersion, d.GetRawConfig())
+}
+
+func chainVersionValidation(existing schema.CustomizeDiffFunc, name string, requirement nsxtVersionRequirement) schema.CustomizeDiffFunc {
+	validation := getVersionValidationCustomizeDiff(name, requirement)
+	if existing == nil {
+		return validation
+	}
+	return customdiff.All(existing, validation)
+}
+
+func getVersionValidationCustomizeDiff(name string, requirement nsxtVersionRequirement) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if m == nil {
+			return nil
+		}
+		if util.NsxVersion == "" {
+			// With on demand connection, NSX version is retrieved when connector is first initialized
+			getPolicyConnector(m)
+		}
+		if util.NsxVersion == "" || util.NsxVersionEstimated {
+			log.Printf("[DEBUG] NSX version is not known, skipping version validation for %s", name)
+			return nil
+		}
+
+		return validateNSXVersionRequirement(name, requirement, util.NsxVersion, d.GetRawConfig())
+	}
+}
+
+func validateNSXVersionRequirement(name string, requirement nsxtVersionRequirement, nsxVersion string, config cty.Value) error {
+	if requirement.version != "" && util.VersionLower(nsxVersion, requirement.version) {
+		return fmt.Errorf("%s requires NSX version %s or higher, connected NSX version is %s", name, requirement.version, nsxVersion)
+	}
+
+	var violations []string
+	for _, key := range getSortedKeys(requirement.attributes) {
+		version := requirement.attributes[key]
+		if util.VersionLower(nsxVersion, version) && isAttributeConfigured(config, strings.Split(key, ".")) {
+			violations = append(violations, fmt.Sprintf("%s requires NSX version %s or higher", key, version))
+		}
+	}
+	for _, key := range getSortedKeys(requirement.values) {
+		for _, value := range getAttributeValues(config, strings.Split(key, ".")) {
+			if version, ok := requirement.values[key][value]; ok && util.VersionLower(nsxVersion, version) {
+				violations = append(violations, fmt.Sprintf("%s %s requires NSX version %s or higher", key, value, version))
+			}
+		}
+	}
+	for _, key := range getSortedKeys(requirement.removedValues) {
+		for _, value := range getAttributeValues(config, strings.Split(key, ".")) {
+			if version, ok := requirement.removedValues[key][value]; ok && !util.VersionLower(nsxVersion, version) {
+				violations = append(violations, fmt.Sprintf("%s %s is not supported from NSX version %s", key, value, version))
+			}
+		}
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("%s: %s, connected NSX version is %s", name, strings.Join(violations, "; "), nsxVersion)
+	}
+
+	return nil
+}
+
+// isAttributeConfigured checks whether attribute at given path is set in configuration,
+// looking into every element of nested blocks
+func isAttributeConfigured(value cty.Value, path []string) bool {
+	if value.IsNull() {
+		return false
+	}
+	if !value.IsKnown() {
+		// value is derived from other resources, and will be set on apply
+		return true
+	}
+
+	valueType := value.Type()
+	if valueType.IsListType() || valueType.IsSetType() || valueType.IsTupleType() || valueType.IsMapType() {
+		index, err := getListIndexFromPath(path)
+		i := 0
+		for it := value.ElementIterator(); it.Next(); i++ {
+			_, elem := it.Element()
+			if err == nil {
+				if i == index {
+					return isAttributeConfigured(elem, path[1:])
+				}
+				continue
+			}
+			if isAttributeConfigured(elem, path) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(path) == 0 {
+		return true
+	}
+	if !valueType.IsObjectType() || !valueType.HasAttribute(path[0]) {
+		return false
+	}
+	return isAttributeConfigured(value.GetAttr(path[0]), path[1:])
+}
+
+func getListIndexFromPath(path []string) (int, error) {
+	if len(path) == 0 {
+		return 0, fmt.Errorf("empty path")
+	}
+	return strconv.Atoi(path[0])
+}
+
+// getAttributeValues returns known string values of attribute at given path in configuration,
+// looking into every element of nested blocks
+func getAttributeValues(value cty.Value, path []string) []string {
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+
+	valueType := value.Type()
+	if valueType.IsListType() || valueType.IsSetType() || valueType.IsTupleType() {
+		var result []string
+		for it := value.ElementIterator(); it.Next(); {
+			_, elem := it.Element()
+			result = append(result, getAttributeValues(elem, path)...)
+		}
+		return result
+	}
+
+	if len(path) == 0 {
+		if valueType == cty.String {
+			return []string{value.AsString()}
+		}
+		return nil
+	}
+	if !valueType.IsObjectType() || !valueType.HasAttribute(path[0]) {
+		return nil
+	}
+	return getAttributeValues(value.GetAttr(path[0]), path[1:])
+}
+
+func getSortedKeys[T any](m map[string]T) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
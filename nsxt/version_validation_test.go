@@ -0,0 +1,242 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"golang.org/x/exp/slices"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
+)
+
+func TestResourceVersionRequirements(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for name, requirement := range resourceVersionRequirements {
+		resource, ok := resources[name]
+		if !ok {
+			t.Errorf("Resource %s with version requirements is not registered in provider", name)
+			continue
+		}
+		var keys []string
+		keys = append(keys, getSortedKeys(requirement.attributes)...)
+		keys = append(keys, getSortedKeys(requirement.values)...)
+		keys = append(keys, getSortedKeys(requirement.removedValues)...)
+		for _, key := range keys {
+			resourceSchema := resource.Schema
+			for _, segment := range strings.Split(key, ".") {
+				if _, err := strconv.Atoi(segment); err == nil {
+					continue
+				}
+				attrSchema, ok := resourceSchema[segment]
+				if !ok {
+					t.Errorf("Attribute %s of resource %s with version requirement is not in schema", key, name)
+					break
+				}
+				if elem, ok := attrSchema.Elem.(*schema.Resource); ok {
+					resourceSchema = elem.Schema
+				}
+			}
+		}
+		if resource.CustomizeDiff == nil {
+			t.Errorf("Version validation is not attached to resource %s", name)
+		}
+	}
+}
+
+func TestValidateNSXVersionRequirement(t *testing.T) {
+	requirement := nsxtVersionRequirement{
+		attributes: map[string]string{
+			"visibility":          "4.2.0",
+			"block.nested_field":  "4.1.0",
+			"unconfigured_string": "4.1.0",
+		},
+	}
+	config := cty.ObjectVal(map[string]cty.Value{
+		"display_name":        cty.StringVal("test"),
+		"visibility":          cty.StringVal("EXTERNAL"),
+		"unconfigured_string": cty.NullVal(cty.String),
+		"block": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"nested_field": cty.NullVal(cty.String),
+			}),
+			cty.ObjectVal(map[string]cty.Value{
+				"nested_field": cty.UnknownVal(cty.String),
+			}),
+		}),
+	})
+
+	if err := validateNSXVersionRequirement("test", requirement, "4.2.0", config); err != nil {
+		t.Errorf("Unexpected error for supported version: %v", err)
+	}
+
+	err := validateNSXVersionRequirement("test", requirement, "4.1.0", config)
+	if err == nil || !strings.Contains(err.Error(), "visibility requires NSX version 4.2.0") || strings.Contains(err.Error(), "nested_field") {
+		t.Errorf("Expected error for visibility only, got: %v", err)
+	}
+
+	err = validateNSXVersionRequirement("test", requirement, "4.0.0", config)
+	if err == nil || !strings.Contains(err.Error(), "block.nested_field requires NSX version 4.1.0") || strings.Contains(err.Error(), "unconfigured_string") {
+		t.Errorf("Expected error for nested attribute, got: %v", err)
+	}
+
+	valueRequirement := nsxtVersionRequirement{
+		attributes:    map[string]string{"block.1": "3.0.0"},
+		values:        map[string]map[string]string{"ha_mode": {"ACTIVE_ACTIVE": "4.0.0"}},
+		removedValues: map[string]map[string]string{"block.action": {"NO_NAT": "3.0.0"}},
+	}
+	valueConfig := cty.ObjectVal(map[string]cty.Value{
+		"ha_mode": cty.StringVal("ACTIVE_ACTIVE"),
+		"block": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{"action": cty.StringVal("NO_NAT")}),
+			cty.ObjectVal(map[string]cty.Value{"action": cty.StringVal("SNAT")}),
+		}),
+	})
+	err = validateNSXVersionRequirement("test", valueRequirement, "3.1.0", valueConfig)
+	if err == nil || !strings.Contains(err.Error(), "ha_mode ACTIVE_ACTIVE requires NSX version 4.0.0") || !strings.Contains(err.Error(), "block.action NO_NAT is not supported from NSX version 3.0.0") || strings.Contains(err.Error(), "block.1") {
+		t.Errorf("Expected value errors, got: %v", err)
+	}
+	err = validateNSXVersionRequirement("test", valueRequirement, "2.5.0", valueConfig)
+	if err == nil || !strings.Contains(err.Error(), "block.1 requires NSX version 3.0.0") || strings.Contains(err.Error(), "NO_NAT") {
+		t.Errorf("Expected list size error, got: %v", err)
+	}
+	valueConfig = cty.ObjectVal(map[string]cty.Value{
+		"ha_mode": cty.StringVal("ACTIVE_STANDBY"),
+		"block":   cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"action": cty.StringVal("SNAT")})}),
+	})
+	if err := validateNSXVersionRequirement("test", valueRequirement, "2.5.0", valueConfig); err != nil {
+		t.Errorf("Unexpected error for supported values: %v", err)
+	}
+
+	requirement.version = "4.1.0"
+	err = validateNSXVersionRequirement("test", requirement, "4.0.1", cty.EmptyObjectVal)
+	if err == nil || !strings.Contains(err.Error(), "test requires NSX version 4.1.0") {
+		t.Errorf("Expected resource version error, got: %v", err)
+	}
+}
+
+func TestIsAttributeConfigured(t *testing.T) {
+	config := cty.ObjectVal(map[string]cty.Value{
+		"empty_block": cty.ListValEmpty(cty.Object(map[string]cty.Type{"field": cty.String})),
+		"tags":        cty.SetVal([]cty.Value{cty.StringVal("a")}),
+		"flag":        cty.False,
+	})
+
+	tests := map[string]bool{
+		"empty_block":       false,
+		"empty_block.field": false,
+		"tags":              true,
+		"flag":              true,
+		"missing":           false,
+	}
+	for key, expected := range tests {
+		if isAttributeConfigured(config, strings.Split(key, ".")) != expected {
+			t.Errorf("Expected configured to be %v for %s", expected, key)
+		}
+	}
+}
+
+func TestVersionValidationPlan(t *testing.T) {
+	server, provider := testSimulatorProvider(t, "2.5.0", nil)
+
+	resource := provider.ResourcesMap["nsxt_policy_mac_discovery_profile"]
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"display_name": "test"})
+	_, err := resource.Diff(context.Background(), nil, config, provider.Meta())
+	if err == nil || !strings.Contains(err.Error(), "requires NSX version 3.0.0") {
+		t.Errorf("Expected plan to fail on NSX 2.5.0, got: %v", err)
+	}
+
+	server.Version = "3.0.0"
+	util.NsxVersion = ""
+	_, err = resource.Diff(context.Background(), nil, config, provider.Meta())
+	if err != nil {
+		t.Errorf("Unexpected plan error on NSX 3.0.0: %v", err)
+	}
+}
+
+func TestVersionValidationApply(t *testing.T) {
+	_, provider := testSimulatorProvider(t, "3.1.0", nil)
+	// Plan time validation is skipped with estimated NSX version
+	util.NsxVersion = "3.1.0"
+	util.NsxVersionEstimated = true
+
+	resource := provider.ResourcesMap["nsxt_policy_tier1_gateway"]
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"display_name": "test", "ha_mode": "ACTIVE_ACTIVE"})
+	diff, err := resource.Diff(context.Background(), nil, config, provider.Meta())
+	if err != nil {
+		t.Fatalf("Unexpected plan error with estimated NSX version: %v", err)
+	}
+
+	diff.RawConfig = cty.ObjectVal(map[string]cty.Value{
+		"display_name": cty.StringVal("test"),
+		"ha_mode":      cty.StringVal("ACTIVE_ACTIVE"),
+	})
+	_, diags := resource.Apply(context.Background(), nil, diff, provider.Meta())
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "requires NSX version 4.0.0") {
+		t.Errorf("Expected apply to fail on NSX 3.1.0, got: %v", diags)
+	}
+}
+
+// TestNoInlineVersionValidation makes sure NSX version requirements are validated
+// via resourceVersionRequirements, rather than by returning errors from inline version checks.
+// Version checks that only choose between API flavors are allowed.
+func TestNoInlineVersionValidation(t *testing.T) {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			ifStmt, ok := n.(*ast.IfStmt)
+			if !ok || !containsCall(ifStmt.Cond, "util", "NsxVersionLower", "NsxVersionHigherOrEqual") {
+				return true
+			}
+			for _, stmt := range ifStmt.Body.List {
+				if ret, ok := stmt.(*ast.ReturnStmt); ok {
+					for _, result := range ret.Results {
+						if containsCall(result, "fmt", "Errorf") {
+							t.Errorf("%s: version requirement should be listed in resourceVersionRequirements rather than validated inline", fset.Position(ret.Pos()))
+						}
+					}
+				}
+			}
+			return true
+		})
+	}
+}
+
+func containsCall(node ast.Node, pkg string, funcs ...string) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return !found
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok && ident.Name == pkg && slices.Contains(funcs, sel.Sel.Name) {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}